@@ -0,0 +1,257 @@
+package phone
+
+import "strings"
+
+// AsYouTypeFormatter formats a number while it is being typed, one digit at
+// a time. Numbers starting with "+" or the region's international dialing
+// prefix get their country once the calling code is unambiguous; other
+// numbers are national numbers of the region. Complete numbers come out as
+// FormatInternational or FormatNational would print them. A partly typed
+// international prefix, and digits no number of the country starts with,
+// are left as typed.
+//
+// Every method returns a single value so the type can be bound with
+// gomobile. An AsYouTypeFormatter is not safe for concurrent use.
+type AsYouTypeFormatter struct {
+	p      *Parser
+	region string
+	// input is the "+" and digits typed so far.
+	input []byte
+	// pos is the index in input where the next digit goes.
+	pos     int
+	out     string
+	country *Country
+}
+
+// NewAsYouTypeFormatter returns a formatter for numbers typed in region
+// with the package default Parser.
+func NewAsYouTypeFormatter(region string) *AsYouTypeFormatter {
+	return DefaultParser().NewAsYouTypeFormatter(region)
+}
+
+// NewAsYouTypeFormatter returns a formatter for numbers typed in the region
+// with the given ISO 3166-1 code. With an empty region, national numbers
+// take the Parser's default country code.
+func (p *Parser) NewAsYouTypeFormatter(region string) *AsYouTypeFormatter {
+	return &AsYouTypeFormatter{p: p, region: region}
+}
+
+// InputDigit adds d at the cursor and returns the formatted number. A "+"
+// is only taken at the start of the number; other characters that are not
+// ASCII digits are ignored.
+func (f *AsYouTypeFormatter) InputDigit(d rune) string {
+	switch {
+	case d == '+' && f.pos == 0 && !f.international():
+	case d >= '0' && d <= '9' && !(f.pos == 0 && f.international()):
+	default:
+		return f.out
+	}
+	f.input = append(f.input, 0)
+	copy(f.input[f.pos+1:], f.input[f.pos:])
+	f.input[f.pos] = byte(d)
+	f.pos++
+	f.format()
+	return f.out
+}
+
+// Backspace removes the digit before the cursor and returns the formatted
+// number.
+func (f *AsYouTypeFormatter) Backspace() string {
+	if f.pos == 0 {
+		return f.out
+	}
+	f.input = append(f.input[:f.pos-1], f.input[f.pos:]...)
+	f.pos--
+	f.format()
+	return f.out
+}
+
+// Clear removes everything typed so far.
+func (f *AsYouTypeFormatter) Clear() {
+	f.input, f.pos, f.out, f.country = nil, 0, "", nil
+}
+
+// String returns the formatted number.
+func (f *AsYouTypeFormatter) String() string {
+	return f.out
+}
+
+// Cursor returns the byte offset in the formatted number just after the
+// digit before the cursor, which is where an input field should put its
+// caret.
+func (f *AsYouTypeFormatter) Cursor() int {
+	if f.pos == 0 {
+		return 0
+	}
+	n := 0
+	for i := 0; i < len(f.out); i++ {
+		if c := f.out[i]; c == '+' || c >= '0' && c <= '9' {
+			n++
+			if n == f.pos {
+				return i + 1
+			}
+		}
+	}
+	return len(f.out)
+}
+
+// SetCursor moves the cursor to the byte offset in the formatted number,
+// e.g. after the user tapped into the middle of it, so the next InputDigit
+// or Backspace edits there.
+func (f *AsYouTypeFormatter) SetCursor(offset int) {
+	if offset > len(f.out) {
+		offset = len(f.out)
+	}
+	n := 0
+	for i := 0; i < offset; i++ {
+		if c := f.out[i]; c == '+' || c >= '0' && c <= '9' {
+			n++
+		}
+	}
+	f.pos = n
+}
+
+// Country returns the country of the number, or nil while it is unknown.
+func (f *AsYouTypeFormatter) Country() *Country {
+	return f.country
+}
+
+func (f *AsYouTypeFormatter) international() bool {
+	return len(f.input) > 0 && f.input[0] == '+'
+}
+
+func (f *AsYouTypeFormatter) format() {
+	typed := string(f.input)
+	f.country = nil
+	f.out = typed
+	if strings.HasPrefix(typed, "+") {
+		if s, ok := f.formatCallingCode(typed[1:]); ok {
+			f.out = "+" + s
+		}
+		return
+	}
+
+	from := f.p.countries().findByCode(strings.TrimPrefix(f.p.defaultCountryCode, "+"))
+	if f.region != "" {
+		from = f.p.countries().findByIsoCode(f.region)
+	}
+	if from == nil {
+		return
+	}
+	ip := from.InternationalPrefix()
+	if ip != "" && strings.HasPrefix(ip, typed) {
+		// Still typing the international prefix, which no national
+		// layout applies to.
+		return
+	}
+	if ip != "" && strings.HasPrefix(typed, ip) {
+		s, ok := f.formatCallingCode(typed[len(ip):])
+		if !ok {
+			s = typed[len(ip):]
+		}
+		f.out = ip + " " + s
+		return
+	}
+	f.country = from
+	prefix := from.NationalPrefix()
+	if !strings.HasPrefix(typed, prefix) {
+		prefix = ""
+	}
+	if s := f.formatNSN(from, typed[len(prefix):], prefix, true); s != "" {
+		f.out = s
+	}
+}
+
+// formatCallingCode formats digits made of a calling code and a national
+// significant number. It reports false until the calling code is known.
+func (f *AsYouTypeFormatter) formatCallingCode(digits string) (string, bool) {
+	data := f.p.countries()
+	code, done := data.trie.resolve(digits)
+	if !done || code == "" {
+		return "", false
+	}
+	f.country = data.findByCode(code)
+	nsn := digits[len(code):]
+	if nsn == "" {
+		return code, true
+	}
+	if s := f.formatNSN(f.country, nsn, "", false); s != "" {
+		return code + " " + s, true
+	}
+	return code + " " + nsn, true
+}
+
+// formatNSN lays out the start of a national significant number, in the
+// national layout after the national prefix if one was typed, or in the
+// international one. It returns "" when the country has no layout for it.
+func (f *AsYouTypeFormatter) formatNSN(country *Country, nsn, prefix string, national bool) string {
+	if nsn == "" {
+		return ""
+	}
+	typed := prefix + nsn
+	_, max := country.LengthRange()
+	for n := len(nsn); n <= max; n++ {
+		fills := "0123456789"
+		if n == len(nsn) {
+			fills = "0"
+		}
+		for _, fill := range fills {
+			padded := nsn + strings.Repeat(string(fill), n-len(nsn))
+			nf := country.formatFor(padded)
+			if nf == nil || !country.couldStart(padded) {
+				continue
+			}
+			s := nf.apply(padded, nf.Format)
+			switch {
+			case !national:
+			case hasDigitPrefix(nf.national(padded, prefix), prefix):
+				s = nf.national(padded, prefix)
+			default:
+				// The national layout drops the prefix, as "(212) 555-0100"
+				// does in the US, but it was typed.
+				s = prefix + " " + s
+			}
+			if s = truncateDigits(s, len(typed)); digitsOnly(s) == typed {
+				return s
+			}
+		}
+	}
+
+	// Complete numbers of countries without formats are split like
+	// FormatNational does.
+	c, err := f.p.Parse("+" + country.CountryCode + nsn)
+	if err != nil || !c.IsPossible() {
+		return ""
+	}
+	s := c.formatGrouped()
+	if prefix != "" {
+		s = prefix + s
+	}
+	if digitsOnly(s) != typed {
+		return ""
+	}
+	return s
+}
+
+// couldStart reports whether number, a typed number padded to full length,
+// is of one of the country's number types, so that its layout fits what
+// was typed. Countries without number_types take any number.
+func (c *Country) couldStart(number string) bool {
+	if len(c.NumberTypes) == 0 {
+		return true
+	}
+	return c.NumberType(number) != Unknown
+}
+
+// truncateDigits cuts s after its n-th digit.
+func truncateDigits(s string, n int) string {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= '0' && s[i] <= '9' {
+			n--
+			if n == 0 {
+				return s[:i+1]
+			}
+		}
+	}
+	return s
+}
@@ -0,0 +1,80 @@
+package phone
+
+import "testing"
+
+func typeAll(f *AsYouTypeFormatter, s string) []string {
+	var outs []string
+	for _, r := range s {
+		outs = append(outs, f.InputDigit(r))
+	}
+	return outs
+}
+
+func TestAsYouTypeFormatter(t *testing.T) {
+	p := newParser(t)
+	tests := []struct {
+		region, typed string
+		want          []string
+	}{
+		{"", "+385915125486", []string{"+", "+3", "+38", "+385", "+385 9", "+385 91", "+385 91 5", "+385 91 51", "+385 91 512", "+385 91 512 5", "+385 91 512 54", "+385 91 512 548", "+385 91 512 5486"}},
+		{"HR", "0915125486", []string{"0", "09", "091", "091 5", "091 51", "091 512", "091 512 5", "091 512 54", "091 512 548", "091 512 5486"}},
+		{"HR", "00385915", []string{"0", "00", "00 3", "00 38", "00 385", "00 385 9", "00 385 91", "00 385 91 5"}},
+		{"US", "2125551234", []string{"(2", "(21", "(212", "(212) 5", "(212) 55", "(212) 555", "(212) 555-1", "(212) 555-12", "(212) 555-123", "(212) 555-1234"}},
+		{"", "+1242", []string{"+", "+1", "+1 2", "+1 24", "+1 242"}},
+		{"US", "01138591", []string{"0", "01", "011", "011 3", "011 38", "011 385", "011 385 9", "011 385 91"}},
+		{"US", "2120", []string{"(2", "(21", "(212", "2120"}},
+	}
+	for _, tt := range tests {
+		f := p.NewAsYouTypeFormatter(tt.region)
+		got := typeAll(f, tt.typed)
+		for i := range tt.want {
+			if i >= len(got) || got[i] != tt.want[i] {
+				t.Errorf("%s %q: got %q, want %q", tt.region, tt.typed, got, tt.want)
+				break
+			}
+		}
+		if f.Cursor() != len(f.String()) {
+			t.Errorf("%s %q: Cursor() = %d, want %d", tt.region, tt.typed, f.Cursor(), len(f.String()))
+		}
+	}
+}
+
+func TestAsYouTypeFormatterMatchesFormat(t *testing.T) {
+	p := newParser(t)
+	for _, s := range []string{"+385915125486", "+38512345678", "+442079460018", "+12125550100", "+4930123456"} {
+		c, err := p.Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f := p.NewAsYouTypeFormatter("")
+		typeAll(f, s)
+		if got, want := f.String(), c.FormatInternational(); got != want {
+			t.Errorf("typing %s gave %q, FormatInternational gives %q", s, got, want)
+		}
+		if f.Country() == nil || "+"+f.Country().CountryCode != c.CountryCode {
+			t.Errorf("typing %s: Country() = %v", s, f.Country())
+		}
+	}
+}
+
+func TestAsYouTypeFormatterEditing(t *testing.T) {
+	f := newParser(t).NewAsYouTypeFormatter("HR")
+	typeAll(f, "091512548")
+	if got := f.Backspace(); got != "091 512 54" {
+		t.Errorf("Backspace() = %q", got)
+	}
+	f.SetCursor(3)
+	if got := f.InputDigit('1'); got != "091 151 254" {
+		t.Errorf("InputDigit at 3 = %q", got)
+	}
+	if got := f.Cursor(); got != 5 {
+		t.Errorf("Cursor() = %d, want 5", got)
+	}
+	if got := f.InputDigit('x'); got != "091 151 254" {
+		t.Errorf("InputDigit('x') = %q", got)
+	}
+	f.Clear()
+	if f.String() != "" || f.Country() != nil {
+		t.Errorf("after Clear: %q, %v", f.String(), f.Country())
+	}
+}
@@ -0,0 +1,122 @@
+package phone
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A number packs into 64 bits as, from the most significant bit down:
+// 10 bits of country code, 4 bits counting the leading zeros of the
+// national significant number and 50 bits holding the rest of it as an
+// integer. E.164 allows at most 14 national digits, which fit in 47 bits.
+const (
+	packedZerosShift = 50
+	packedCodeShift  = 54
+	packedNSNMask    = 1<<packedZerosShift - 1
+	packedZerosMask  = 1<<(packedCodeShift-packedZerosShift) - 1
+)
+
+// Uint64 packs the country code and national significant number into an
+// integer that FromUint64 turns back into the same number, e.g. for sets of
+// numbers too large to keep as Phone values. Packed numbers compare equal
+// exactly when their E.164 forms do. The extension and subaddress are left
+// out. Uint64 returns 0, which is never a packed number, if the number has
+// no country code or is longer than E.164 allows.
+func (c *Phone) Uint64() uint64 {
+	cc := digitsOnly(c.CountryCode)
+	nsn := c.NationalSignificantNumber()
+	if cc == "" || len(cc) > 3 || nsn == "" || len(cc)+len(nsn) > MaxE164Length {
+		return 0
+	}
+	code, _ := strconv.ParseUint(cc, 10, 16)
+	if code == 0 {
+		return 0
+	}
+	rest := strings.TrimLeft(nsn, "0")
+	var n uint64
+	if rest != "" {
+		n, _ = strconv.ParseUint(rest, 10, 64)
+	}
+	zeros := uint64(len(nsn) - len(rest))
+	return code<<packedCodeShift | zeros<<packedZerosShift | n
+}
+
+// FromUint64 unpacks a number packed by Uint64 with the package default
+// Parser.
+func FromUint64(u uint64) (*Phone, error) {
+	return DefaultParser().FromUint64(u)
+}
+
+// FromUint64 unpacks a number packed by Uint64. The national significant
+// number is kept digit for digit, leading zeros included; the area code,
+// where the country has area_code data, and the region are derived from
+// it. Errors are of type *ParseError.
+func (p *Parser) FromUint64(u uint64) (*Phone, error) {
+	code := u >> packedCodeShift
+	zeros := int(u >> packedZerosShift & packedZerosMask)
+	if code == 0 {
+		return nil, newParseError(NoCountry, strconv.FormatUint(u, 10), -1)
+	}
+	cc := strconv.FormatUint(code, 10)
+	nsn := strings.Repeat("0", zeros)
+	if n := u & packedNSNMask; n != 0 {
+		nsn += strconv.FormatUint(n, 10)
+	}
+	country := p.FindByCountryCode(cc)
+	if country == nil {
+		return nil, newParseError(UnknownCountryCode, "+"+cc+nsn, 0)
+	}
+	c := &Phone{
+		N1Length:           "3",
+		CountryCode:        "+" + cc,
+		Number:             nsn,
+		Region:             country.RegionFor(nsn).Alpha2,
+		DefaultCountryCode: p.defaultCountryCode,
+		DefaultAreaCode:    p.defaultAreaCode,
+		country:            country,
+		data:               p.countries(),
+	}
+	if country.AreaCode != "" {
+		if area := compiled("^(?:" + country.AreaCode + ")").FindString(nsn); area != "" && area != nsn {
+			c.AreaCode, c.Number = area, nsn[len(area):]
+		}
+	}
+	return c, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler as the 8 big-endian
+// bytes of Uint64.
+func (c Phone) MarshalBinary() ([]byte, error) {
+	u := c.Uint64()
+	if u == 0 {
+		r := TooLong
+		switch {
+		case digitsOnly(c.CountryCode) == "":
+			r = NoCountry
+		case c.NationalSignificantNumber() == "":
+			r = TooShort
+		}
+		return nil, newParseError(r, c.E164(), -1)
+	}
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, u)
+	return b, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler with FromUint64.
+// c is left unchanged on error.
+func (c *Phone) UnmarshalBinary(data []byte) error {
+	if len(data) != 8 {
+		err := newParseError(InvalidNumber, fmt.Sprintf("%x", data), -1)
+		err.Detail = "packed numbers are 8 bytes"
+		return err
+	}
+	parsed, err := FromUint64(binary.BigEndian.Uint64(data))
+	if err != nil {
+		return err
+	}
+	*c = *parsed
+	return nil
+}
@@ -0,0 +1,105 @@
+package phone
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestUint64RoundTrip(t *testing.T) {
+	p := newParser(t)
+	seen := map[uint64]string{}
+	for _, s := range []string{"+385915125486", "+38512345678", "+12125550100", "+442079460018", "+4930123456", "+79123456789"} {
+		c, err := p.Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		u := c.Uint64()
+		if u == 0 {
+			t.Fatalf("%s: Uint64() = 0", s)
+		}
+		if prev, ok := seen[u]; ok {
+			t.Errorf("%s and %s both pack to %d", s, prev, u)
+		}
+		seen[u] = s
+		got, err := p.FromUint64(u)
+		if err != nil {
+			t.Fatalf("FromUint64(%d): %v", u, err)
+		}
+		if got.E164() != s || got.AreaCode != c.AreaCode || got.Region != c.Region {
+			t.Errorf("FromUint64(Uint64(%s)) = %+v", s, got)
+		}
+	}
+}
+
+func TestUint64LeadingZeros(t *testing.T) {
+	p := newParser(t)
+	for _, c := range []*Phone{
+		{CountryCode: "39", AreaCode: "06", Number: "12345678"},
+		{CountryCode: "+39", Number: "0012345678"},
+		{CountryCode: "81", AreaCode: "3", Number: "12345678"},
+		{CountryCode: "81", Number: "0312345678"},
+		{CountryCode: "385", Number: "0"},
+	} {
+		want := c.E164()
+		got, err := p.FromUint64(c.Uint64())
+		if err != nil || got.E164() != want {
+			t.Errorf("FromUint64(Uint64(%s)) = %v, %v", want, got, err)
+		}
+
+		b, err := c.MarshalBinary()
+		if err != nil {
+			t.Fatalf("%s: MarshalBinary: %v", want, err)
+		}
+		var decoded Phone
+		if err := decoded.UnmarshalBinary(b); err != nil || decoded.E164() != want {
+			t.Errorf("UnmarshalBinary(MarshalBinary(%s)) = %s, %v", want, decoded.E164(), err)
+		}
+	}
+}
+
+func TestFromUint64Parts(t *testing.T) {
+	p := newParser(t)
+	got, err := p.FromUint64((&Phone{CountryCode: "39", Number: "0612345678"}).Uint64())
+	if err != nil || got.Region != "IT" || got.NationalSignificantNumber() != "0612345678" {
+		t.Errorf("FromUint64 = %+v, %v", got, err)
+	}
+	if _, err := p.FromUint64(999<<packedCodeShift | 12345678); !errors.Is(err, ErrUnknownCountryCode) {
+		t.Errorf("FromUint64 of +999 = %v", err)
+	}
+}
+
+func TestUint64Invalid(t *testing.T) {
+	for _, c := range []*Phone{
+		{Number: "5125486"},
+		{CountryCode: "385"},
+		{CountryCode: "385", AreaCode: "91", Number: "51254861234567"},
+	} {
+		if u := c.Uint64(); u != 0 {
+			t.Errorf("%+v: Uint64() = %d, want 0", c, u)
+		}
+		if _, err := c.MarshalBinary(); err == nil {
+			t.Errorf("%+v: MarshalBinary: no error", c)
+		}
+	}
+	if _, err := FromUint64(0); !errors.Is(err, ErrNoCountry) {
+		t.Errorf("FromUint64(0) = %v", err)
+	}
+}
+
+func TestMarshalBinary(t *testing.T) {
+	c, err := newParser(t).Parse("+385915125486")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := c.MarshalBinary()
+	if err != nil || len(b) != 8 {
+		t.Fatalf("MarshalBinary() = %x, %v", b, err)
+	}
+	var got Phone
+	if err := got.UnmarshalBinary(b); err != nil || got.E164() != c.E164() {
+		t.Errorf("UnmarshalBinary(%x) = %+v, %v", b, got, err)
+	}
+	if err := got.UnmarshalBinary(b[:4]); !errors.Is(err, ErrInvalidNumber) {
+		t.Errorf("UnmarshalBinary of 4 bytes = %v", err)
+	}
+}
@@ -1,12 +1,17 @@
 package phone
 
 import (
+	"bytes"
 	"fmt"
+	"strings"
 	"testing"
 )
 
 func TestLoad(t *testing.T) {
-	c := Load()
+	c, err := LoadCountries(bytes.NewReader(countriesYAML))
+	if err != nil {
+		t.Fatal(err)
+	}
 	fmt.Printf("c lenth is  %d", len(c))
 }
 
@@ -16,13 +21,13 @@ func TestExtractExtension(t *testing.T) {
 }
 
 func TestValid(t *testing.T) {
-	c := Valid("+385915125486")
+	c := IsValid("+385915125486")
 	fmt.Printf("phone is %v", c)
 }
 
 func TestParse(t *testing.T) {
 	c, err := Parse("+00385915125486")
-	s := c.ToS()
+	s := c.String()
 	fmt.Printf("phone is %v \n", c)
 	fmt.Printf("error is %v \n", err)
 	fmt.Printf("phone string %v \n", s)
@@ -43,11 +48,11 @@ func TestNew(t *testing.T) {
 func TestFormat(t *testing.T) {
 	c, err := Parse("+00385915125486x148")
 	fmt.Printf("error is %v \n", err)
-	f := c.format("%A/%f-%l")
-	n := c.format("+ %c (%a) %n")
-	europe := c.format("europe")
-	us := c.format("us")
-	ex := c.format("default_with_extension")
+	f := c.Format("%A/%f-%l")
+	n := c.Format("+ %c (%a) %n")
+	europe := c.Format("europe")
+	us := c.Format("us")
+	ex := c.Format("default_with_extension")
 	fmt.Printf("c is %v \n", c)
 	fmt.Printf("f is %v \n", f)
 	fmt.Printf("n is %v \n", n)
@@ -60,11 +65,11 @@ func TestSetDefault(t *testing.T) {
 	SetDefaultCountryCode("385")
 	c, err := Parse("451-588")
 	fmt.Printf("error is %v \n", err)
-	f := c.format("%A/%f-%l")
-	n := c.format("+ %c (%a) %n")
-	europe := c.format("europe")
-	us := c.format("us")
-	ex := c.format("default_with_extension")
+	f := c.Format("%A/%f-%l")
+	n := c.Format("+ %c (%a) %n")
+	europe := c.Format("europe")
+	us := c.Format("us")
+	ex := c.Format("default_with_extension")
 	fmt.Printf("c is %v \n", c)
 	fmt.Printf("f is %v \n", f)
 	fmt.Printf("n is %v \n", n)
@@ -82,3 +87,208 @@ func TestFindByCountryCode(t *testing.T) {
 	f := FindByCountryCode("385222222222")
 	fmt.Printf("f is %v \n", f)
 }
+
+func TestCountriesData(t *testing.T) {
+	c, err := parseCountries(countriesYAML)
+	if err != nil {
+		t.Fatalf("embedded country data: %v", err)
+	}
+	if len(c) == 0 {
+		t.Fatal("embedded country data is empty")
+	}
+}
+
+func TestParseCountriesRejectsBadData(t *testing.T) {
+	tests := map[string]string{
+		"duplicate": `
+"385":
+  country_code: "385"
+  name: Croatia
+  char_3_code: HR
+  national_dialing_prefix: "0"
+  international_dialing_prefix: "0"
+"385":
+  country_code: "385"
+  name: Croatia
+  char_3_code: HR
+  national_dialing_prefix: "0"
+  international_dialing_prefix: "0"
+`,
+		"key mismatch": `
+"386":
+  country_code: "385"
+  name: Croatia
+  char_3_code: HR
+  national_dialing_prefix: "0"
+  international_dialing_prefix: "0"
+`,
+		"bad area code": `
+"385":
+  country_code: "385"
+  name: Croatia
+  char_3_code: HR
+  national_dialing_prefix: "0"
+  international_dialing_prefix: "0"
+  area_code: "[1-"
+`,
+		"unknown attribute": `
+"385":
+  country_code: "385"
+  name: Croatia
+  char_3_code: HR
+  national_dialing_prefix: "0"
+  international_dialing_prefix: "0"
+  areacode: "1"
+`,
+		"max length over E.164": `
+"49":
+  country_code: "49"
+  name: Germany
+  alpha_2: DE
+  national_dialing_prefix: "0"
+  international_dialing_prefix: "00"
+  max_length: 14
+`,
+	}
+	for name, data := range tests {
+		if _, err := parseCountries([]byte(data)); err == nil {
+			t.Errorf("%s: no error", name)
+		}
+	}
+}
+
+func TestMergeCountries(t *testing.T) {
+	overrides, err := LoadCountryOverrides(strings.NewReader(`
+"385":
+  area_code: "1|9[1-9]"
+"49":
+  national_dialing_prefix: "00"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	merged, err := MergeCountries(BuiltinCountries(), overrides)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(merged) != len(Countries) {
+		t.Errorf("merged has %d countries, want %d", len(merged), len(Countries))
+	}
+	if Countries["385"].AreaCode == "1|9[1-9]" {
+		t.Error("merge modified the built-in data")
+	}
+
+	hr := merged["385"]
+	want := Countries["385"]
+	if hr.Name != want.Name || hr.Alpha2 != "HR" || hr.MaxLength != want.MaxLength ||
+		len(hr.NumberTypes) != len(want.NumberTypes) || len(hr.NumberFormats) != len(want.NumberFormats) {
+		t.Errorf("merged Croatia lost its data: %+v", hr)
+	}
+	if de := merged["49"]; de.NationalDialingPrefix != "00" || de.Char2Code != "00" || de.Name != "Germany" {
+		t.Errorf("merged Germany is %+v", de)
+	}
+
+	p := newParser(t, WithCountries(merged))
+	if c := p.FindByCountryCode("385"); c == nil || c.AreaCode != "1|9[1-9]" {
+		t.Errorf("parser country is %v", c)
+	}
+	c, err := p.Parse("+385915125486")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Type() != Mobile || c.FormatNational() != "091 512 5486" {
+		t.Errorf("Parse = %s, %v", c.FormatNational(), c.Type())
+	}
+}
+
+func TestMergeCountriesIncomplete(t *testing.T) {
+	overrides, err := LoadCountryOverrides(strings.NewReader(`
+"999":
+  area_code: "1"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := MergeCountries(BuiltinCountries(), overrides); err == nil {
+		t.Error("MergeCountries accepted an incomplete new country")
+	}
+	if _, err := LoadCountryOverrides(strings.NewReader(`"385": {area: "1"}`)); err == nil {
+		t.Error("LoadCountryOverrides accepted an unknown attribute")
+	}
+}
+
+func TestWithCountriesValidates(t *testing.T) {
+	bad := map[string]Country{"385": {
+		Name:                       "Croatia",
+		CountryCode:                "385",
+		Alpha2:                     "HR",
+		NationalDialingPrefix:      "0",
+		InternationalDialingPrefix: "00",
+		NumberFormats:              []NumberFormat{{Pattern: "(9[", Format: "$1"}},
+	}}
+	if p, err := NewParser(WithCountries(bad)); err == nil || p != nil {
+		t.Errorf("NewParser with an invalid table = %v, %v", p, err)
+	}
+}
+
+func TestFindByCountryIsoCodeForms(t *testing.T) {
+	for _, code := range []string{"hr", "HR", "hrv", "191"} {
+		c := FindByCountryIsoCode(code)
+		if c == nil || c.CountryCode != "385" {
+			t.Errorf("FindByCountryIsoCode(%q) = %v", code, c)
+			continue
+		}
+		if c.Alpha2 != "HR" || c.Alpha3 != "HRV" || c.Numeric != "191" {
+			t.Errorf("Croatia codes are %q %q %q", c.Alpha2, c.Alpha3, c.Numeric)
+		}
+	}
+	if c := FindByCountryIsoCode("36"); c == nil || c.Alpha2 != "AU" {
+		t.Errorf("FindByCountryIsoCode(36) = %v", c)
+	}
+}
+
+func TestRegions(t *testing.T) {
+	tests := map[string]string{
+		"+12125550100":  "US",
+		"+14165550100":  "CA",
+		"+12425550100":  "BS",
+		"+74951234567":  "RU",
+		"+77011234567":  "KZ",
+		"+441534123456": "JE",
+		"+442079460018": "GB",
+		"+385915125486": "HR",
+	}
+	for input, want := range tests {
+		c, err := Parse(input)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", input, err)
+			continue
+		}
+		if c.Region != want {
+			t.Errorf("Parse(%q).Region = %q, want %q", input, c.Region, want)
+		}
+	}
+
+	regions := RegionsForCountryCode("1")
+	if len(regions) < 2 || regions[0] != "US" || regions[1] != "CA" {
+		t.Errorf("RegionsForCountryCode(1) = %v", regions)
+	}
+	for iso, want := range map[string]string{"US": "1", "ca": "1", "JEY": "44", "KZ": "7", "HR": "385", "XX": ""} {
+		if got := CountryCodeForRegion(iso); got != want {
+			t.Errorf("CountryCodeForRegion(%q) = %q, want %q", iso, got, want)
+		}
+	}
+	if c := FindByCountryIsoCode("CA"); c == nil || c.Name != "Canada" || c.CountryCode != "1" {
+		t.Errorf("FindByCountryIsoCode(CA) = %v", c)
+	}
+}
+
+func TestDeprecatedCodesFilled(t *testing.T) {
+	c := FindByCountryCode("385")
+	if c.Char3Code != "HR" || c.Char2Code != "0" {
+		t.Errorf("Char3Code, Char2Code = %q, %q, want HR, 0", c.Char3Code, c.Char2Code)
+	}
+	if c := FindByCountryIsoCode("CA"); c == nil || c.Char3Code != "CA" {
+		t.Errorf("FindByCountryIsoCode(CA) = %v", c)
+	}
+}
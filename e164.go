@@ -0,0 +1,83 @@
+package phone
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MaxE164Length is the maximum number of digits in an E.164 number,
+// country code included (ITU-T E.164 section 6.1).
+const MaxE164Length = 15
+
+const e164Format = `^\+[1-9][0-9]{1,14}$`
+
+// ParseE164 parses s with the package default Parser, accepting only the
+// canonical E.164 form "+<country code><national significant number>".
+func ParseE164(s string) (*Phone, error) {
+	return DefaultParser().ParseE164(s)
+}
+
+// ParseE164 parses s, accepting only the canonical E.164 form
+// "+<country code><national significant number>". The result is guaranteed
+// to format back to s through E164.
+func (p *Parser) ParseE164(s string) (*Phone, error) {
+	if err := checkE164(s); err != nil {
+		return nil, err
+	}
+	c, err := p.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	if c.E164() != s {
+		err := newParseError(InvalidNumber, s, -1)
+		err.Detail = fmt.Sprintf("not in canonical form, which is %s", c.E164())
+		return nil, err
+	}
+	return c, nil
+}
+
+// checkE164 reports the first way in which s departs from the E.164 form.
+func checkE164(s string) error {
+	switch {
+	case s == "":
+		return newParseError(TooShort, s, -1)
+	case s[0] != '+':
+		return newParseError(NoCountry, s, 0)
+	}
+	if loc := regexp.MustCompile(`[^0-9]`).FindStringIndex(s[1:]); loc != nil {
+		return newParseError(InvalidCharacters, s, loc[0]+1)
+	}
+	switch {
+	case len(s) > MaxE164Length+1:
+		return newParseError(TooLong, s, MaxE164Length+1)
+	case !regexp.MustCompile(e164Format).MatchString(s):
+		if len(s) > 1 && s[1] == '0' {
+			return newParseError(UnknownCountryCode, s, 0)
+		}
+		return newParseError(TooShort, s, -1)
+	}
+	return nil
+}
+
+// E164 returns the number in E.164 form, e.g. "+385915125486". The
+// extension is not part of E.164 and is left out.
+func (c *Phone) E164() string {
+	return "+" + digitsOnly(c.CountryCode) + c.NationalSignificantNumber()
+}
+
+// NationalSignificantNumber returns the area code followed by the
+// subscriber number, without any national trunk prefix.
+func (c *Phone) NationalSignificantNumber() string {
+	return digitsOnly(c.AreaCode + c.Number)
+}
+
+func digitsOnly(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,56 @@
+package phone
+
+import "testing"
+
+func TestParseE164(t *testing.T) {
+	for _, s := range []string{"+385915125486", "+4420794600018"} {
+		c, err := ParseE164(s)
+		if err != nil {
+			t.Errorf("ParseE164(%q): %v", s, err)
+			continue
+		}
+		if c.E164() != s {
+			t.Errorf("ParseE164(%q).E164() = %q", s, c.E164())
+		}
+		if c.String() != s {
+			t.Errorf("ParseE164(%q).String() = %q", s, c.String())
+		}
+	}
+
+	for _, s := range []string{"00385915125486", "+385 91 512 5486", "+0385915125486", "+3859151254861234", ""} {
+		if _, err := ParseE164(s); err == nil {
+			t.Errorf("ParseE164(%q) accepted a non canonical number", s)
+		}
+	}
+}
+
+func TestParseE164NotCanonical(t *testing.T) {
+	_, err := newParser(t).ParseE164("+3850915125486")
+	pe, ok := err.(*ParseError)
+	if !ok || pe.Reason != InvalidNumber || pe.Detail == "" {
+		t.Errorf("ParseE164(+3850915125486) = %v", err)
+	}
+}
+
+func TestParseKeepsPlusInCountryCode(t *testing.T) {
+	c, err := newParser(t).Parse("+385915125486")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.CountryCode != "+385" {
+		t.Errorf("CountryCode = %q, want %q", c.CountryCode, "+385")
+	}
+	if got := c.Format("+ %c (%a) %n"); got != "+385 (91) 5125486" {
+		t.Errorf("Format = %q", got)
+	}
+}
+
+func TestE164(t *testing.T) {
+	c, err := New([]string{"5125486", "91", "+385", "148"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.E164() != "+385915125486" {
+		t.Errorf("E164() = %q", c.E164())
+	}
+}
@@ -0,0 +1,55 @@
+package phone
+
+import "strings"
+
+// DefaultENUMSuffix is the ENUM domain of the public DNS (RFC 6116).
+const DefaultENUMSuffix = "e164.arpa"
+
+// ENUMDomain returns the RFC 6116 domain name of the number under suffix,
+// e.g. "6.8.4.5.2.1.5.1.9.5.8.3.e164.arpa". An empty suffix means
+// DefaultENUMSuffix.
+func (c *Phone) ENUMDomain(suffix string) string {
+	if suffix == "" {
+		suffix = DefaultENUMSuffix
+	}
+	digits := strings.TrimPrefix(c.E164(), "+")
+	var b strings.Builder
+	for i := len(digits) - 1; i >= 0; i-- {
+		b.WriteByte(digits[i])
+		b.WriteByte('.')
+	}
+	b.WriteString(strings.Trim(suffix, "."))
+	return b.String()
+}
+
+// ParseENUMDomain parses an ENUM domain name with the package default
+// Parser.
+func ParseENUMDomain(domain string) (*Phone, error) {
+	return DefaultParser().ParseENUMDomain(domain)
+}
+
+// ParseENUMDomain turns an RFC 6116 domain name back into a number. The
+// leading single digit labels are the reversed number; whatever follows
+// them is the suffix, which must not be empty.
+func (p *Parser) ParseENUMDomain(domain string) (*Phone, error) {
+	labels := strings.Split(strings.TrimSuffix(domain, "."), ".")
+	n := 0
+	for n < len(labels) && len(labels[n]) == 1 && labels[n][0] >= '0' && labels[n][0] <= '9' {
+		n++
+	}
+	if n == len(labels) {
+		return nil, newParseError(InvalidURI, domain, -1)
+	}
+	if n == 0 {
+		return nil, newParseError(TooShort, domain, -1)
+	}
+	if n > MaxE164Length {
+		return nil, newParseError(TooLong, domain, 2*MaxE164Length)
+	}
+	var b strings.Builder
+	b.WriteByte('+')
+	for i := n - 1; i >= 0; i-- {
+		b.WriteString(labels[i])
+	}
+	return p.parseNormalized(domain, b.String(), "")
+}
@@ -0,0 +1,48 @@
+package phone
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestENUMDomain(t *testing.T) {
+	c, err := Parse("+385915125486")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := c.ENUMDomain(""); got != "6.8.4.5.2.1.5.1.9.5.8.3.e164.arpa" {
+		t.Errorf("ENUMDomain(\"\") = %q", got)
+	}
+	if got := c.ENUMDomain("enum.example.com."); got != "6.8.4.5.2.1.5.1.9.5.8.3.enum.example.com" {
+		t.Errorf("ENUMDomain(enum.example.com.) = %q", got)
+	}
+}
+
+func TestParseENUMDomain(t *testing.T) {
+	for _, domain := range []string{
+		"6.8.4.5.2.1.5.1.9.5.8.3.e164.arpa",
+		"6.8.4.5.2.1.5.1.9.5.8.3.e164.arpa.",
+		"6.8.4.5.2.1.5.1.9.5.8.3.enum.example.com",
+	} {
+		c, err := ParseENUMDomain(domain)
+		if err != nil {
+			t.Errorf("ParseENUMDomain(%q): %v", domain, err)
+			continue
+		}
+		if c.E164() != "+385915125486" {
+			t.Errorf("ParseENUMDomain(%q) = %s", domain, c.E164())
+		}
+	}
+
+	bad := map[string]error{
+		"6.8.4.5.2.1.5.1.9.5.8.3":                   ErrInvalidURI,
+		"e164.arpa":                                 ErrTooShort,
+		"68.4.5.2.1.5.1.9.5.8.3.e164.arpa":          ErrTooShort,
+		"1.2.3.4.5.6.7.8.9.0.1.2.3.4.5.6.e164.arpa": ErrTooLong,
+	}
+	for domain, want := range bad {
+		if _, err := ParseENUMDomain(domain); !errors.Is(err, want) {
+			t.Errorf("ParseENUMDomain(%q) error = %v, want %v", domain, err, want)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package phone
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Reason tells why a number was rejected.
+type Reason int
+
+const (
+	// NoCountry means the input has no country code and no default is set.
+	NoCountry Reason = iota + 1
+	// UnknownCountryCode means the country code is not in the country data.
+	UnknownCountryCode
+	// TooShort means the input has too few digits to be a number.
+	TooShort
+	// TooLong means the input has more digits than a number may have.
+	TooLong
+	// InvalidAreaCode means no valid area code was found or set.
+	InvalidAreaCode
+	// InvalidCharacters means the input contains characters a number
+	// cannot contain.
+	InvalidCharacters
+	// InvalidNumber means the number is in none of the ranges its country
+	// allocates.
+	InvalidNumber
+	// InvalidURI means a URI or ENUM domain holding a number is malformed.
+	InvalidURI
+)
+
+// Sentinel errors matching each Reason. A *ParseError wraps the one for its
+// Reason, so errors.Is(err, ErrTooShort) works on any error returned by
+// this package.
+var (
+	ErrNoCountry          = errors.New("must specify country code")
+	ErrUnknownCountryCode = errors.New("unknown country code")
+	ErrTooShort           = errors.New("number too short")
+	ErrTooLong            = errors.New("number too long")
+	ErrInvalidAreaCode    = errors.New("must enter area code or set default")
+	ErrInvalidCharacters  = errors.New("number contains invalid characters")
+	ErrInvalidNumber      = errors.New("number is not in an allocated range")
+	ErrInvalidURI         = errors.New("malformed URI")
+)
+
+var reasonErrors = map[Reason]error{
+	NoCountry:          ErrNoCountry,
+	UnknownCountryCode: ErrUnknownCountryCode,
+	TooShort:           ErrTooShort,
+	TooLong:            ErrTooLong,
+	InvalidAreaCode:    ErrInvalidAreaCode,
+	InvalidCharacters:  ErrInvalidCharacters,
+	InvalidNumber:      ErrInvalidNumber,
+	InvalidURI:         ErrInvalidURI,
+}
+
+// Err returns the sentinel error for r.
+func (r Reason) Err() error {
+	return reasonErrors[r]
+}
+
+func (r Reason) String() string {
+	if err, found := reasonErrors[r]; found {
+		return err.Error()
+	}
+	return fmt.Sprintf("Reason(%d)", int(r))
+}
+
+// ParseError describes why Input could not be turned into a Phone.
+type ParseError struct {
+	Reason Reason
+	// Input is the string handed to the parser.
+	Input string
+	// Offset is the byte offset in Input where the problem was found, or
+	// -1 if it is not tied to a position. Country code problems are at
+	// offset 0.
+	Offset int
+	// Detail optionally spells out the rule that failed.
+	Detail string
+}
+
+func newParseError(r Reason, input string, offset int) *ParseError {
+	return &ParseError{Reason: r, Input: input, Offset: offset}
+}
+
+func (e *ParseError) Error() string {
+	msg := e.Reason.String()
+	if e.Detail != "" {
+		msg += " (" + e.Detail + ")"
+	}
+	if e.Offset < 0 {
+		return fmt.Sprintf("phone: parsing %q: %s", e.Input, msg)
+	}
+	return fmt.Sprintf("phone: parsing %q at offset %d: %s", e.Input, e.Offset, msg)
+}
+
+// Unwrap returns the sentinel error for e.Reason.
+func (e *ParseError) Unwrap() error {
+	return e.Reason.Err()
+}
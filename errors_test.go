@@ -0,0 +1,73 @@
+package phone
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		parser *Parser
+		input  string
+		want   error
+		offset int
+	}{
+		{newParser(t), "", ErrTooShort, -1},
+		{newParser(t), "+1", ErrTooShort, -1},
+		{newParser(t), "+385", ErrTooShort, -1},
+		{newParser(t), "0915125486", ErrNoCountry, 0},
+		{newParser(t), "+999123456", ErrUnknownCountryCode, 0},
+		{newParser(t, WithDefaultCountryCode("999")), "0915125486", ErrUnknownCountryCode, 0},
+		{newParser(t), "+3859151254861234567", ErrTooLong, -1},
+		{newParser(t, WithLenient(false)), "091 512a5486", ErrInvalidCharacters, 7},
+	}
+	for _, tt := range tests {
+		_, err := tt.parser.Parse(tt.input)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("Parse(%q) error = %v, want %v", tt.input, err, tt.want)
+			continue
+		}
+		var pe *ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("Parse(%q) error %T is not a *ParseError", tt.input, err)
+			continue
+		}
+		if pe.Input != tt.input || pe.Offset != tt.offset {
+			t.Errorf("Parse(%q) error input %q offset %d, want offset %d", tt.input, pe.Input, pe.Offset, tt.offset)
+		}
+	}
+}
+
+func TestUnknownCountryCodeOffset(t *testing.T) {
+	var pe *ParseError
+	if _, err := ParseE164("+999123456"); !errors.As(err, &pe) || pe.Reason != UnknownCountryCode || pe.Offset != 0 {
+		t.Errorf("ParseE164(+999123456) = %v", err)
+	}
+	if err := (&Phone{CountryCode: "999", Number: "123456"}).Validate(); !errors.As(err, &pe) || pe.Offset != 0 {
+		t.Errorf("Validate of +999123456 = %v", err)
+	}
+}
+
+func TestShortNumbersFormat(t *testing.T) {
+	for _, s := range []string{"+385 1 234", "+3851", "+12"} {
+		c, err := newParser(t).Parse(s)
+		if err != nil {
+			continue
+		}
+		// Formatting must not panic however short the number is.
+		_ = c.String()
+		_ = c.Format("europe")
+		_ = c.Format("us")
+		if c.IsPossible() {
+			t.Errorf("Parse(%q) is possible", s)
+		}
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	_, err := newParser(t).New([]string{"5125486", "", "385"})
+	var pe *ParseError
+	if !errors.As(err, &pe) || pe.Reason != InvalidAreaCode {
+		t.Errorf("New error = %v, want InvalidAreaCode", err)
+	}
+}
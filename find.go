@@ -0,0 +1,137 @@
+package phone
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Match is a number found in text by FindNumbers.
+type Match struct {
+	Phone *Phone
+	// Start and End are the byte offsets of Raw in the text.
+	Start, End int
+	// Raw is the number as written in the text.
+	Raw string
+}
+
+// candidateSpaces are the separators at which a candidate holding several
+// numbers is split.
+const candidateSpaces = " \t"
+
+var (
+	// candidateExp matches runs of at least six digits, optionally led by
+	// "+" or "(", with up to three separators between digits and an
+	// optional extension.
+	candidateExp = regexp.MustCompile(`[+(]?\d(?:[ ().\-/]{0,3}\d){5,}(?:[ \t]*(?:ext\.?|x|#)[ \t]*\d{1,6})?`)
+	// dateExp matches dates, which look like national numbers.
+	dateExp = regexp.MustCompile(`^\d{1,4}[-./]\d{1,2}[-./]\d{1,4}$`)
+)
+
+// FindNumbers finds numbers in text with the package default Parser.
+func FindNumbers(text string, region string) []Match {
+	return DefaultParser().FindNumbers(text, region)
+}
+
+// FindNumbers finds the valid numbers in text, as dialed from region (see
+// ParseFrom). With an empty region, numbers without a country code are only
+// found if the Parser has a default country code. Digit runs glued to
+// letters or other digits, dates and numbers failing Validate are skipped.
+// Several numbers separated only by spaces or punctuation, as in
+// "0915125486 0915125487", are found one by one.
+func (p *Parser) FindNumbers(text string, region string) []Match {
+	return p.findNumbers(text, region, func(c *Phone) bool {
+		return c.Validate() == nil
+	}, false)
+}
+
+// minCandidateDigits is the fewest digits candidateExp accepts.
+const minCandidateDigits = 6
+
+// findNumbers finds the numbers in text that parse and that accept takes.
+// A candidate that fails as a whole is retried piece by piece, splitting
+// it at its spaces, longest pieces first. With unparsed, digit runs that
+// do not parse at all but are no longer than E.164 allows are found too,
+// with a nil Phone.
+func (p *Parser) findNumbers(text, region string, accept func(*Phone) bool, unparsed bool) []Match {
+	var matches []Match
+	for _, loc := range candidateExp.FindAllStringIndex(text, -1) {
+		start, end := loc[0], loc[1]
+		if r, _ := utf8.DecodeLastRuneInString(text[:start]); isWordRune(r) || r == '+' {
+			continue
+		}
+		if r, _ := utf8.DecodeRuneInString(text[end:]); isWordRune(r) {
+			continue
+		}
+		if dateExp.MatchString(text[start:end]) {
+			continue
+		}
+		if m, ok := p.matchSpan(text, start, end, region, accept, unparsed); ok {
+			matches = append(matches, m)
+			continue
+		}
+		tokens := separatedTokens(text, start, end)
+		for i := 0; i < len(tokens) && len(tokens) > 1; i++ {
+			for j := len(tokens) - 1; j >= i; j-- {
+				s, e := tokens[i][0], tokens[j][1]
+				if len(digitsOnly(text[s:e])) < minCandidateDigits {
+					break
+				}
+				if m, ok := p.matchSpan(text, s, e, region, accept, unparsed); ok {
+					matches = append(matches, m)
+					i = j
+					break
+				}
+			}
+		}
+	}
+	return matches
+}
+
+// matchSpan parses text[start:end] as a number.
+func (p *Parser) matchSpan(text string, start, end int, region string, accept func(*Phone) bool, unparsed bool) (Match, bool) {
+	raw := text[start:end]
+	if dateExp.MatchString(raw) {
+		return Match{}, false
+	}
+	var c *Phone
+	var err error
+	if region != "" {
+		c, err = p.ParseFrom(raw, region)
+	} else {
+		c, err = p.Parse(raw)
+	}
+	if err != nil && unparsed && len(digitsOnly(raw)) <= MaxE164Length {
+		return Match{Start: start, End: end, Raw: raw}, true
+	}
+	if err != nil || !accept(c) {
+		return Match{}, false
+	}
+	return Match{Phone: c, Start: start, End: end, Raw: raw}, true
+}
+
+// separatedTokens returns the start and end offsets of the runs of text
+// between start and end that are split by spaces.
+func separatedTokens(text string, start, end int) [][2]int {
+	var tokens [][2]int
+	tokenStart := -1
+	for i := start; i < end; i++ {
+		if strings.IndexByte(candidateSpaces, text[i]) >= 0 {
+			if tokenStart >= 0 {
+				tokens = append(tokens, [2]int{tokenStart, i})
+				tokenStart = -1
+			}
+		} else if tokenStart < 0 {
+			tokenStart = i
+		}
+	}
+	if tokenStart >= 0 {
+		tokens = append(tokens, [2]int{tokenStart, end})
+	}
+	return tokens
+}
+
+func isWordRune(r rune) bool {
+	return r != utf8.RuneError && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
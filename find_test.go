@@ -0,0 +1,51 @@
+package phone
+
+import "testing"
+
+func TestFindNumbers(t *testing.T) {
+	text := "Call 091/512-5486 or +44 20 7946 0018 ext. 12 before 2026-10-18, " +
+		"not order 12345678901234567890 or abc0915125486. US office: 00 1 212 555 0100."
+	want := []struct {
+		raw, e164 string
+	}{
+		{"091/512-5486", "+385915125486"},
+		{"+44 20 7946 0018 ext. 12", "+442079460018"},
+		{"00 1 212 555 0100", "+12125550100"},
+	}
+
+	matches := FindNumbers(text, "HR")
+	if len(matches) != len(want) {
+		t.Fatalf("FindNumbers found %d numbers: %+v", len(matches), matches)
+	}
+	for i, m := range matches {
+		if m.Raw != want[i].raw || m.Phone.E164() != want[i].e164 {
+			t.Errorf("match %d = %q %s, want %q %s", i, m.Raw, m.Phone.E164(), want[i].raw, want[i].e164)
+		}
+		if text[m.Start:m.End] != m.Raw {
+			t.Errorf("match %d span %d:%d is %q", i, m.Start, m.End, text[m.Start:m.End])
+		}
+	}
+	if matches[1].Phone.Extension == "" {
+		t.Error("extension was not parsed")
+	}
+}
+
+func TestFindNumbersWithoutRegion(t *testing.T) {
+	matches := newParser(t).FindNumbers("blabla 091/512-5486 blabla +385 91 512 5486", "")
+	if len(matches) != 1 || matches[0].Raw != "+385 91 512 5486" {
+		t.Errorf("FindNumbers = %+v", matches)
+	}
+}
+
+func TestFindNumbersSplitsRuns(t *testing.T) {
+	text := "numbers 0915125486 0915125487, done"
+	matches := newParser(t).FindNumbers(text, "HR")
+	if len(matches) != 2 || matches[0].Raw != "0915125486" || matches[1].Raw != "0915125487" {
+		t.Fatalf("FindNumbers = %+v", matches)
+	}
+	for _, m := range matches {
+		if text[m.Start:m.End] != m.Raw {
+			t.Errorf("span %d:%d is %q, want %q", m.Start, m.End, text[m.Start:m.End], m.Raw)
+		}
+	}
+}
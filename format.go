@@ -0,0 +1,149 @@
+package phone
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// NumberFormat groups the digits of national significant numbers matching
+// Pattern.
+type NumberFormat struct {
+	// Pattern is a regular expression matching whole national significant
+	// numbers, with one group per block of digits.
+	Pattern string `yaml:"pattern"`
+	// Format lays out the groups as $1, $2, ... e.g. "$1 $2 $3".
+	Format string `yaml:"format"`
+	// NationalFormat replaces Format in national output, with $NP standing
+	// for the national dialing prefix. When empty, the prefix is put in
+	// front of Format.
+	NationalFormat string `yaml:"national_format"`
+}
+
+var groupRefExp = regexp.MustCompile(`\$([0-9]+)`)
+
+func (f *NumberFormat) validate() error {
+	if f.Format == "" {
+		return fmt.Errorf("missing format")
+	}
+	if _, err := regexp.Compile(f.Pattern); err != nil {
+		return fmt.Errorf("invalid pattern: %v", err)
+	}
+	return nil
+}
+
+// apply lays out number, which must match f.Pattern, by template.
+func (f *NumberFormat) apply(number, template string) string {
+	re := compiled("^(?:" + f.Pattern + ")$")
+	// "$1$2" and "$1x" would be read as group names, so spell them ${1}.
+	template = groupRefExp.ReplaceAllString(template, "$${$1}")
+	return re.ReplaceAllString(number, template)
+}
+
+// national lays out number for dialing within the country.
+func (f *NumberFormat) national(number, prefix string) string {
+	if f.NationalFormat == "" {
+		return prefix + f.apply(number, f.Format)
+	}
+	return f.apply(number, strings.Replace(f.NationalFormat, "$NP", prefix, -1))
+}
+
+// formatFor returns the first of the country's formats matching number, or
+// nil.
+func (c *Country) formatFor(number string) *NumberFormat {
+	for i := range c.NumberFormats {
+		if matchWhole(c.NumberFormats[i].Pattern, number) {
+			return &c.NumberFormats[i]
+		}
+	}
+	return nil
+}
+
+// NationalPrefix returns the prefix dialed before national numbers within
+// the country, e.g. "0", or "" if there is none.
+func (c *Country) NationalPrefix() string {
+	if c.NationalDialingPrefix == "None" {
+		return ""
+	}
+	return c.NationalDialingPrefix
+}
+
+// FormatNational formats the number as dialed within its country, with the
+// national prefix and the country's digit grouping, e.g. "091 512 5486".
+// Numbers matching none of the country's formats are split into area code
+// and number.
+func (c *Phone) FormatNational() string {
+	nsn := c.NationalSignificantNumber()
+	country := c.Country()
+	if country == nil {
+		return nsn
+	}
+	prefix := country.NationalPrefix()
+	if f := country.formatFor(nsn); f != nil {
+		return f.national(nsn, prefix)
+	}
+	if c.AreaCode == "" {
+		return prefix + nsn
+	}
+	return prefix + c.AreaCode + " " + c.Number
+}
+
+// FormatInternational formats the number as dialed from abroad in the ITU-T
+// E.123 style, with the country's digit grouping, e.g. "+385 91 512 5486".
+// Numbers matching none of the country's formats are split into area code
+// and number.
+func (c *Phone) FormatInternational() string {
+	return "+" + digitsOnly(c.CountryCode) + " " + c.formatGrouped()
+}
+
+// formatGrouped returns the national significant number grouped by the
+// country's formats, without any prefix.
+func (c *Phone) formatGrouped() string {
+	nsn := c.NationalSignificantNumber()
+	if country := c.Country(); country != nil {
+		if f := country.formatFor(nsn); f != nil {
+			return f.apply(nsn, f.Format)
+		}
+	}
+	if c.AreaCode == "" {
+		return nsn
+	}
+	return c.AreaCode + " " + c.Number
+}
+
+// nanpCountryCode is the calling code of the North American Numbering
+// Plan, whose regions dial each other with the national prefix 1.
+const nanpCountryCode = "1"
+
+// InternationalPrefix returns the prefix dialed before calling codes from
+// the country, e.g. "00" or "011", or "" if there is none.
+func (c *Country) InternationalPrefix() string {
+	if c.InternationalDialingPrefix == "None" {
+		return ""
+	}
+	return c.InternationalDialingPrefix
+}
+
+// FormatOutOfCountry formats the number as dialed from the region with the
+// given ISO 3166-1 code: "00 385 91 512 5486" from Germany, "011 385 91 512
+// 5486" from the United States and "091 512 5486" from Croatia itself. The
+// region is looked up in the country data the number was parsed with. An
+// unknown region gets FormatInternational.
+func (c *Phone) FormatOutOfCountry(fromRegion string) string {
+	from := c.countries().findByIsoCode(fromRegion)
+	if from == nil {
+		return c.FormatInternational()
+	}
+	cc := digitsOnly(c.CountryCode)
+	if from.CountryCode == cc {
+		if cc == nanpCountryCode && c.Region != "" && !strings.EqualFold(from.Alpha2, c.Region) {
+			return nanpCountryCode + " " + c.formatGrouped()
+		}
+		return c.FormatNational()
+	}
+	prefix := from.InternationalPrefix()
+	if prefix == "" {
+		return c.FormatInternational()
+	}
+	return prefix + " " + cc + " " + c.formatGrouped()
+}
@@ -0,0 +1,132 @@
+package phone
+
+import "testing"
+
+func TestFormatNational(t *testing.T) {
+	tests := map[string]string{
+		"+385915125486":  "091 512 5486",
+		"+38514812345":   "01 4812 345",
+		"+12125550100":   "(212) 555-0100",
+		"+14165550100":   "(416) 555-0100",
+		"+442079460018":  "020 7946 0018",
+		"+447911123456":  "07911 123456",
+		"+33123456789":   "01 23 45 67 89",
+		"+74951234567":   "8 (495) 123-45-67",
+		"+61412345678":   "0412 345 678",
+		"+4915112345678": "0151 12345678",
+		"+49301234567":   "030 1234567",
+		"+49892345678":   "089 2345678",
+		"+492211234567":  "0221 1234567",
+		"+49228123456":   "0228 123456",
+		"+49345123456":   "0345 123456",
+		"+4990612345":    "0906 12345",
+		"+4933201234567": "03320 1234567",
+		"+3612345678":    "061 2345678",
+	}
+	for input, want := range tests {
+		c, err := Parse(input)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", input, err)
+			continue
+		}
+		if got := c.FormatNational(); got != want {
+			t.Errorf("Parse(%q).FormatNational() = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestFormatInternational(t *testing.T) {
+	tests := map[string]string{
+		"+385915125486":  "+385 91 512 5486",
+		"+12125550100":   "+1 212-555-0100",
+		"+442079460018":  "+44 20 7946 0018",
+		"+33123456789":   "+33 1 23 45 67 89",
+		"+74951234567":   "+7 495 123-45-67",
+		"+61412345678":   "+61 412 345 678",
+		"+4915112345678": "+49 151 12345678",
+		"+49301234567":   "+49 30 1234567",
+	}
+	for input, want := range tests {
+		c, err := Parse(input)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", input, err)
+			continue
+		}
+		if got := c.FormatInternational(); got != want {
+			t.Errorf("Parse(%q).FormatInternational() = %q, want %q", input, got, want)
+		}
+	}
+
+	c, err := New([]string{"5125486", "91", "385"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := c.FormatInternational(); got != "+385 91 512 5486" {
+		t.Errorf("New(...).FormatInternational() = %q", got)
+	}
+}
+
+func TestFormatOutOfCountry(t *testing.T) {
+	tests := []struct {
+		input, from, want string
+	}{
+		{"+385915125486", "DE", "00 385 91 512 5486"},
+		{"+385915125486", "US", "011 385 91 512 5486"},
+		{"+385915125486", "HR", "091 512 5486"},
+		{"+385915125486", "RU", "810 385 91 512 5486"},
+		{"+385915125486", "AU", "0011 385 91 512 5486"},
+		{"+385915125486", "XX", "+385 91 512 5486"},
+		{"+14165550100", "US", "1 416-555-0100"},
+		{"+14165550100", "CA", "(416) 555-0100"},
+		{"+442079460018", "JP", "010 44 20 7946 0018"},
+		{"+385915125486", "GE", "00 385 91 512 5486"},
+		{"+385915125486", "MU", "00 385 91 512 5486"},
+		{"+385915125486", "FM", "00 385 91 512 5486"},
+		{"+385915125486", "MH", "011 385 91 512 5486"},
+	}
+	for _, tt := range tests {
+		c, err := Parse(tt.input)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.input, err)
+			continue
+		}
+		if got := c.FormatOutOfCountry(tt.from); got != tt.want {
+			t.Errorf("Parse(%q).FormatOutOfCountry(%q) = %q, want %q", tt.input, tt.from, got, tt.want)
+		}
+	}
+}
+
+func TestFormatOutOfCountryUsesParserData(t *testing.T) {
+	countries := BuiltinCountries()
+	de := countries["49"]
+	de.InternationalDialingPrefix = "0099"
+	countries["49"] = de
+	c, err := newParser(t, WithCountries(countries)).Parse("+385915125486")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.FormatOutOfCountry("DE"), "0099 385 91 512 5486"; got != want {
+		t.Errorf("FormatOutOfCountry(DE) = %q, want %q", got, want)
+	}
+}
+
+func TestGermanAreaCodes(t *testing.T) {
+	tests := map[string]string{
+		"+49301234567":   "30",
+		"+492211234567":  "221",
+		"+49228123456":   "228",
+		"+49345123456":   "345",
+		"+4933201234567": "3320",
+	}
+	p := newParser(t)
+	for input, want := range tests {
+		c, err := p.Parse(input)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", input, err)
+			continue
+		}
+		if c.AreaCode != want {
+			t.Errorf("Parse(%q).AreaCode = %q, want %q", input, c.AreaCode, want)
+		}
+	}
+}
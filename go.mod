@@ -1,5 +1,5 @@
 module github/yunshang/phoner
 
-go 1.15
+go 1.21
 
 require gopkg.in/yaml.v2 v2.4.0
@@ -0,0 +1,239 @@
+package phone
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// phoneObject is the form Object marshals to.
+type phoneObject struct {
+	E164        string `json:"e164" yaml:"e164"`
+	CountryCode string `json:"country_code,omitempty" yaml:"country_code,omitempty"`
+	AreaCode    string `json:"area_code,omitempty" yaml:"area_code,omitempty"`
+	Number      string `json:"number,omitempty" yaml:"number,omitempty"`
+	Extension   string `json:"extension,omitempty" yaml:"extension,omitempty"`
+	Subaddress  string `json:"subaddress,omitempty" yaml:"subaddress,omitempty"`
+	Region      string `json:"region,omitempty" yaml:"region,omitempty"`
+}
+
+func (c *Phone) object() phoneObject {
+	return phoneObject{
+		E164:        c.E164(),
+		CountryCode: digitsOnly(c.CountryCode),
+		AreaCode:    c.AreaCode,
+		Number:      c.Number,
+		Extension:   digitsOnly(c.Extension),
+		Subaddress:  c.Subaddress,
+		Region:      c.Region,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler. It writes the E.164
+// string, followed by "x" and the extension if there is one, e.g.
+// "+385915125486x148". The zero Phone is written as empty text; a Phone
+// with only one of country code and number is an error of type
+// *ParseError, as it could not be read back.
+func (c Phone) MarshalText() ([]byte, error) {
+	if c.isZero() {
+		return []byte{}, nil
+	}
+	if err := c.checkParts(); err != nil {
+		return nil, err
+	}
+	s := c.E164()
+	if ext := digitsOnly(c.Extension); ext != "" {
+		s += "x" + ext
+	}
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler by parsing text with
+// the package default Parser. Empty text gives the zero Phone. Use
+// Parser.DecodeText to parse with another Parser. Errors are of type
+// *ParseError.
+func (c *Phone) UnmarshalText(text []byte) error {
+	parsed, err := DefaultParser().DecodeText(text)
+	if err != nil {
+		return err
+	}
+	c.set(parsed)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler with the MarshalText string.
+// Convert to Object to write the parts as well.
+func (c Phone) MarshalJSON() ([]byte, error) {
+	text, err := c.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler with the package default
+// Parser. It accepts a string, an object as written by Object, or null,
+// which leaves c unchanged. The empty string gives the zero Phone. Use
+// Parser.DecodeJSON to parse with another Parser.
+func (c *Phone) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	parsed, err := DefaultParser().DecodeJSON(data)
+	if err != nil {
+		return err
+	}
+	c.set(parsed)
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler with the MarshalText string.
+// Convert to Object to write the parts as well.
+func (c Phone) MarshalYAML() (interface{}, error) {
+	text, err := c.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler with the package default
+// Parser. It accepts a string or a mapping as written by Object. The empty
+// string gives the zero Phone. Use Parser.DecodeYAML to parse with another
+// Parser.
+func (c *Phone) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	parsed, err := DefaultParser().DecodeYAML(unmarshal)
+	if err != nil {
+		return err
+	}
+	c.set(parsed)
+	return nil
+}
+
+// set makes c a copy of parsed, or the zero Phone if parsed is nil.
+func (c *Phone) set(parsed *Phone) {
+	if parsed == nil {
+		*c = Phone{}
+		return
+	}
+	*c = *parsed
+}
+
+// isZero reports whether c has neither country code nor number, as the
+// zero Phone.
+func (c *Phone) isZero() bool {
+	return digitsOnly(c.CountryCode) == "" && c.NationalSignificantNumber() == ""
+}
+
+// checkParts reports a Phone missing its country code or number, which
+// could not be parsed back from its E.164 form.
+func (c *Phone) checkParts() error {
+	switch {
+	case digitsOnly(c.CountryCode) == "":
+		return newParseError(NoCountry, c.E164(), -1)
+	case c.NationalSignificantNumber() == "":
+		return newParseError(TooShort, c.E164(), -1)
+	}
+	return nil
+}
+
+// Object is a Phone that marshals to JSON and YAML as an object with its
+// E.164 string and parts, e.g.
+// {"e164":"+385915125486","country_code":"385",...}. Convert a Phone, as
+// in Object(*pn), to pick this form for one value or struct field.
+type Object Phone
+
+// MarshalJSON implements json.Marshaler. The zero Object is written as
+// the empty string, as the zero Phone is.
+func (o Object) MarshalJSON() ([]byte, error) {
+	c := Phone(o)
+	if c.isZero() {
+		return c.MarshalJSON()
+	}
+	if err := c.checkParts(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(c.object())
+}
+
+// UnmarshalJSON implements json.Unmarshaler as Phone does.
+func (o *Object) UnmarshalJSON(data []byte) error {
+	return (*Phone)(o).UnmarshalJSON(data)
+}
+
+// MarshalYAML implements yaml.Marshaler. The zero Object is written as
+// the empty string, as the zero Phone is.
+func (o Object) MarshalYAML() (interface{}, error) {
+	c := Phone(o)
+	if c.isZero() {
+		return c.MarshalYAML()
+	}
+	if err := c.checkParts(); err != nil {
+		return nil, err
+	}
+	return c.object(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler as Phone does.
+func (o *Object) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return (*Phone)(o).UnmarshalYAML(unmarshal)
+}
+
+// DecodeText parses text as written by MarshalText. It returns nil and no
+// error for empty text, which MarshalText writes for the zero Phone.
+// Errors are of type *ParseError.
+func (p *Parser) DecodeText(text []byte) (*Phone, error) {
+	if len(bytes.TrimSpace(text)) == 0 {
+		return nil, nil
+	}
+	return p.Parse(string(text))
+}
+
+// DecodeJSON parses a JSON string, or an object as written by Object. It
+// returns nil and no error for null and the empty string.
+func (p *Parser) DecodeJSON(data []byte) (*Phone, error) {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		return nil, nil
+	case len(data) > 0 && data[0] == '{':
+		var obj phoneObject
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return nil, err
+		}
+		return p.fromObject(obj)
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("phone: cannot unmarshal %s into a Phone", data)
+	}
+	return p.DecodeText([]byte(s))
+}
+
+// DecodeYAML parses a YAML string, or a mapping as written by Object,
+// read with the unmarshal function a yaml.Unmarshaler is given.
+func (p *Parser) DecodeYAML(unmarshal func(interface{}) error) (*Phone, error) {
+	var s string
+	if err := unmarshal(&s); err == nil {
+		return p.DecodeText([]byte(s))
+	}
+	var obj phoneObject
+	if err := unmarshal(&obj); err != nil {
+		return nil, fmt.Errorf("phone: cannot unmarshal YAML into a Phone: %v", err)
+	}
+	return p.fromObject(obj)
+}
+
+// fromObject parses the E.164 string of obj and takes the extension and
+// subaddress from it. The other parts are derived again by the parser.
+func (p *Parser) fromObject(obj phoneObject) (*Phone, error) {
+	parsed, err := p.Parse(obj.E164)
+	if err != nil {
+		return nil, err
+	}
+	if obj.Extension != "" {
+		parsed.Extension = obj.Extension
+	}
+	parsed.Subaddress = obj.Subaddress
+	return parsed, nil
+}
@@ -0,0 +1,180 @@
+package phone
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+type contact struct {
+	Name  string `json:"name" yaml:"name"`
+	Phone *Phone `json:"phone" yaml:"phone"`
+}
+
+func TestMarshalJSON(t *testing.T) {
+	c, err := newParser(t).Parse("+385915125486 x148")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(contact{Name: "Ana", Phone: c})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"name":"Ana","phone":"+385915125486x148"}`; string(b) != want {
+		t.Errorf("json.Marshal = %s, want %s", b, want)
+	}
+
+	b, err = json.Marshal(struct {
+		Work  Object `json:"work"`
+		Other *Phone `json:"other"`
+	}{Object(*c), c})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"work":{"e164":"+385915125486","country_code":"385","area_code":"91","number":"5125486","extension":"148","region":"HR"},"other":"+385915125486x148"}`; string(b) != want {
+		t.Errorf("json.Marshal = %s, want %s", b, want)
+	}
+
+	var o Object
+	if err := json.Unmarshal([]byte(`"+385915125486"`), &o); err != nil || (*Phone)(&o).E164() != c.E164() {
+		t.Errorf("json.Unmarshal into Object = %+v, %v", o, err)
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	p := newParser(t, WithDefaultCountryCode("44"))
+	for _, data := range []string{`"020 7946 0018"`, `{"e164":"+442079460018"}`} {
+		c, err := p.DecodeJSON([]byte(data))
+		if err != nil || c.E164() != "+442079460018" {
+			t.Errorf("DecodeJSON(%s) = %v, %v", data, c, err)
+		}
+	}
+	if c, err := p.DecodeJSON([]byte("null")); c != nil || err != nil {
+		t.Errorf("DecodeJSON(null) = %v, %v", c, err)
+	}
+	if _, err := p.DecodeJSON([]byte("42")); err == nil {
+		t.Error("DecodeJSON(42): no error")
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	for _, data := range []string{
+		`{"phone":"+385915125486x148"}`,
+		`{"phone":{"e164":"+385915125486","extension":"148"}}`,
+	} {
+		var ct contact
+		if err := json.Unmarshal([]byte(data), &ct); err != nil {
+			t.Fatalf("json.Unmarshal(%s): %v", data, err)
+		}
+		if ct.Phone.E164() != "+385915125486" || digitsOnly(ct.Phone.Extension) != "148" || ct.Phone.AreaCode != "91" {
+			t.Errorf("json.Unmarshal(%s) = %+v", data, ct.Phone)
+		}
+	}
+
+	var ct contact
+	if err := json.Unmarshal([]byte(`{"phone":null}`), &ct); err != nil || ct.Phone != nil {
+		t.Errorf("null phone = %v, %v", ct.Phone, err)
+	}
+
+	err := json.Unmarshal([]byte(`{"phone":"+999123"}`), &ct)
+	var pe *ParseError
+	if !errors.As(err, &pe) || pe.Reason != UnknownCountryCode {
+		t.Errorf("invalid phone: err = %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"phone":42}`), &ct); err == nil {
+		t.Error("number phone: no error")
+	}
+}
+
+func TestMarshalText(t *testing.T) {
+	c, err := newParser(t).Parse("+385915125486")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := c.MarshalText()
+	var got Phone
+	if err := got.UnmarshalText(b); err != nil {
+		t.Fatal(err)
+	}
+	if got.E164() != c.E164() || got.Region != "HR" {
+		t.Errorf("round trip of %s = %+v", b, got)
+	}
+	if err := got.UnmarshalText([]byte("n/a")); !errors.Is(err, ErrTooShort) {
+		t.Errorf("UnmarshalText(n/a) = %v", err)
+	}
+}
+
+func TestMarshalYAML(t *testing.T) {
+	c, err := newParser(t).Parse("+442079460018")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := yaml.Marshal(contact{Name: "Bob", Phone: c})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "name: Bob\nphone: \"+442079460018\"\n"; string(b) != want {
+		t.Errorf("yaml.Marshal = %q, want %q", b, want)
+	}
+	var ct contact
+	if err := yaml.Unmarshal(b, &ct); err != nil || ct.Phone.E164() != c.E164() {
+		t.Errorf("yaml.Unmarshal = %+v, %v", ct.Phone, err)
+	}
+
+	if b, err = yaml.Marshal(map[string]Object{"work": Object(*c)}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), "e164: \"+442079460018\"") {
+		t.Errorf("yaml.Marshal(Object) = %q", b)
+	}
+	var objects map[string]Object
+	if err := yaml.Unmarshal(b, &objects); err != nil {
+		t.Fatalf("yaml.Unmarshal(%s): %v", b, err)
+	}
+	if got := Phone(objects["work"]); got.E164() != c.E164() || got.Region != "GB" {
+		t.Errorf("yaml.Unmarshal(%s) = %+v", b, got)
+	}
+}
+
+func TestMarshalZero(t *testing.T) {
+	type record struct {
+		Name  string `json:"name" yaml:"name"`
+		Phone Phone  `json:"phone" yaml:"phone"`
+		Work  Object `json:"work" yaml:"work"`
+	}
+	b, err := json.Marshal(record{Name: "Ana"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"name":"Ana","phone":"","work":""}`; string(b) != want {
+		t.Errorf("json.Marshal = %s, want %s", b, want)
+	}
+	var got record
+	if err := json.Unmarshal(b, &got); err != nil || !got.Phone.isZero() || !(*Phone)(&got.Work).isZero() {
+		t.Errorf("json.Unmarshal(%s) = %+v, %v", b, got, err)
+	}
+
+	b, err = yaml.Marshal(record{Name: "Ana"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = record{}
+	if err := yaml.Unmarshal(b, &got); err != nil || !got.Phone.isZero() || got.Name != "Ana" {
+		t.Errorf("yaml.Unmarshal(%s) = %+v, %v", b, got, err)
+	}
+
+	for _, c := range []Phone{{CountryCode: "385"}, {Number: "5125486"}} {
+		if _, err := json.Marshal(c); err == nil {
+			t.Errorf("json.Marshal(%+v): no error", c)
+		}
+		if _, err := json.Marshal(Object(c)); err == nil {
+			t.Errorf("json.Marshal(Object(%+v)): no error", c)
+		}
+		if _, err := c.MarshalText(); err == nil {
+			t.Errorf("MarshalText(%+v): no error", c)
+		}
+	}
+}
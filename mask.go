@@ -0,0 +1,55 @@
+package phone
+
+// MaskLayout selects the format Mask lays the masked number out in.
+type MaskLayout int
+
+const (
+	// MaskInternational masks FormatInternational, e.g. "+385 ** *** **86".
+	MaskInternational MaskLayout = iota
+	// MaskNational masks FormatNational, e.g. "0** *** **86".
+	MaskNational
+	// MaskE164 masks E164, e.g. "+385*******86".
+	MaskE164
+)
+
+// MaskOptions configures Mask.
+type MaskOptions struct {
+	Layout MaskLayout
+	// Mask replaces hidden digits; zero means '*'.
+	Mask rune
+	// KeepLast is the number of final digits left visible; zero means 2,
+	// negative means none.
+	KeepLast int
+}
+
+// Mask returns the number in the chosen layout with every digit hidden but
+// the country code, or the national prefix in MaskNational, and the last
+// opts.KeepLast digits. The extension is left out.
+func (c *Phone) Mask(opts MaskOptions) string {
+	ro := RedactOptions{Mask: opts.Mask, KeepLast: opts.KeepLast}
+	var s string
+	var keep int
+	switch opts.Layout {
+	case MaskNational:
+		s = c.FormatNational()
+		if country := c.Country(); country != nil {
+			prefix := country.NationalPrefix()
+			if len(digitsOnly(s)) > len(c.NationalSignificantNumber()) && hasDigitPrefix(s, prefix) {
+				keep = len(prefix)
+			}
+		}
+	case MaskE164:
+		s = c.E164()
+		keep = len(digitsOnly(c.CountryCode))
+	default:
+		s = c.FormatInternational()
+		keep = len(digitsOnly(c.CountryCode))
+	}
+	return maskDigits(s, keep, ro.keepLast(), ro.mask())
+}
+
+// hasDigitPrefix reports whether the digits of s start with prefix.
+func hasDigitPrefix(s, prefix string) bool {
+	d := digitsOnly(s)
+	return len(d) >= len(prefix) && d[:len(prefix)] == prefix
+}
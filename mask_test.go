@@ -0,0 +1,29 @@
+package phone
+
+import "testing"
+
+func TestMask(t *testing.T) {
+	p := newParser(t)
+	tests := []struct {
+		in   string
+		opts MaskOptions
+		want string
+	}{
+		{"+385915125486", MaskOptions{}, "+385 ** *** **86"},
+		{"+385915125486", MaskOptions{Layout: MaskNational}, "0** *** **86"},
+		{"+385915125486", MaskOptions{Layout: MaskE164}, "+385*******86"},
+		{"+385915125486", MaskOptions{Mask: '#', KeepLast: 4}, "+385 ## ### 5486"},
+		{"+385915125486", MaskOptions{KeepLast: -1}, "+385 ** *** ****"},
+		{"+385915125486 x148", MaskOptions{}, "+385 ** *** **86"},
+		{"+12125551234", MaskOptions{Layout: MaskNational}, "(***) ***-**34"},
+	}
+	for _, tt := range tests {
+		c, err := p.Parse(tt.in)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", tt.in, err)
+		}
+		if got := c.Mask(tt.opts); got != tt.want {
+			t.Errorf("Parse(%q).Mask(%+v) = %q, want %q", tt.in, tt.opts, got, tt.want)
+		}
+	}
+}
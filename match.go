@@ -0,0 +1,159 @@
+package phone
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MatchType tells how closely two numbers match.
+type MatchType int
+
+const (
+	// NoMatch means the numbers are different.
+	NoMatch MatchType = iota
+	// ShortNSNMatch means one national significant number is a suffix of
+	// the other and at least as long as its country's shortest number, or
+	// they are the same but only one has an extension.
+	ShortNSNMatch
+	// NSNMatch means the national significant numbers and extensions are
+	// the same but only one side, or neither, has a country code.
+	NSNMatch
+	// ExactMatch means country code, national significant number and
+	// extension are all the same.
+	ExactMatch
+)
+
+func (m MatchType) String() string {
+	switch m {
+	case NoMatch:
+		return "NoMatch"
+	case ShortNSNMatch:
+		return "ShortNSNMatch"
+	case NSNMatch:
+		return "NSNMatch"
+	case ExactMatch:
+		return "ExactMatch"
+	}
+	return fmt.Sprintf("MatchType(%d)", int(m))
+}
+
+// Equal reports whether a and b are the same number with the same
+// extension. Two nil Phones are equal.
+func Equal(a, b *Phone) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.E164() == b.E164() && digitsOnly(a.Extension) == digitsOnly(b.Extension)
+}
+
+// MatchNumbers compares two numbers as written, such as "091 512 5486" and
+// "+385915125486", with the package default Parser.
+func MatchNumbers(a, b string) MatchType {
+	return DefaultParser().MatchNumbers(a, b)
+}
+
+// MatchNumbers compares two numbers as written. Only numbers starting with
+// "+" or "00" count as having a country code; a number without one is read
+// as dialed in the region of the other, so "091 512 5486" and
+// "+385915125486" are an NSNMatch. Numbers with different country codes or
+// different extensions never match, and text that holds no number matches
+// nothing.
+func (p *Parser) MatchNumbers(a, b string) MatchType {
+	x, y := p.matchOperand(a), p.matchOperand(b)
+	switch {
+	case x.nsn == "" || y.nsn == "":
+		return NoMatch
+	case x.explicit && !y.explicit:
+		y = p.matchOperandFrom(b, x.region)
+	case y.explicit && !x.explicit:
+		x = p.matchOperandFrom(a, y.region)
+	}
+	if x.nsn == "" || y.nsn == "" {
+		return NoMatch
+	}
+	if x.explicit && y.explicit && x.cc != y.cc {
+		return NoMatch
+	}
+	if x.ext != "" && y.ext != "" && x.ext != y.ext {
+		return NoMatch
+	}
+	if x.nsn == y.nsn && x.ext == y.ext {
+		if x.explicit && y.explicit {
+			return ExactMatch
+		}
+		return NSNMatch
+	}
+	short, long := x.nsn, y.nsn
+	if len(short) > len(long) {
+		short, long = long, short
+	}
+	if strings.HasSuffix(long, short) && len(short) >= p.minShortNSN(x.cc, y.cc) {
+		return ShortNSNMatch
+	}
+	return NoMatch
+}
+
+// minShortNSNLength is the fewest digits a national significant number
+// needs to match the end of another when its country has no min_length.
+const minShortNSNLength = 6
+
+// minShortNSN returns the fewest digits a national significant number
+// needs to match the end of another: the min_length of the country with
+// one of the codes, or minShortNSNLength.
+func (p *Parser) minShortNSN(codes ...string) int {
+	for _, cc := range codes {
+		if cc == "" {
+			continue
+		}
+		if c := p.FindByCountryCode(cc); c != nil && c.MinLength > 0 {
+			return c.MinLength
+		}
+	}
+	return minShortNSNLength
+}
+
+// matchOperand is one side of MatchNumbers.
+type matchOperand struct {
+	// explicit is true if the number was written with its country code.
+	explicit bool
+	cc       string
+	nsn      string
+	ext      string
+	region   string
+}
+
+func (p *Parser) matchOperand(s string) matchOperand {
+	sub, ext := extractExtension(s)
+	digits := dialedDigits(sub)
+	op := matchOperand{
+		explicit: strings.HasPrefix(digits, "+") || strings.HasPrefix(digits, "00"),
+		ext:      digitsOnly(ext),
+	}
+	if c, err := p.Parse(s); err == nil {
+		op.cc, op.nsn, op.region = digitsOnly(c.CountryCode), c.NationalSignificantNumber(), c.Region
+	} else if !op.explicit {
+		// Without a country the national prefix is unknown; leading
+		// zeros are the usual one.
+		op.nsn = strings.TrimLeft(digits, "0")
+	}
+	return op
+}
+
+// matchOperandFrom reads s as dialed in region, falling back to
+// matchOperand when that fails.
+func (p *Parser) matchOperandFrom(s, region string) matchOperand {
+	if region == "" {
+		return p.matchOperand(s)
+	}
+	c, err := p.ParseFrom(s, region)
+	if err != nil {
+		return p.matchOperand(s)
+	}
+	_, ext := extractExtension(s)
+	return matchOperand{
+		cc:     digitsOnly(c.CountryCode),
+		nsn:    c.NationalSignificantNumber(),
+		ext:    digitsOnly(ext),
+		region: region,
+	}
+}
@@ -0,0 +1,62 @@
+package phone
+
+import "testing"
+
+func TestEqual(t *testing.T) {
+	p := newParser(t)
+	parse := func(s string) *Phone {
+		c, err := p.Parse(s)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", s, err)
+		}
+		return c
+	}
+	tests := []struct {
+		a, b *Phone
+		want bool
+	}{
+		{parse("+385915125486"), parse("+385 91 512 5486"), true},
+		{parse("+385915125486 x148"), parse("00385 91 512 5486 ext. 148"), true},
+		{parse("+385915125486 x148"), parse("+385915125486"), false},
+		{parse("+385915125486"), parse("+385915125487"), false},
+		{nil, nil, true},
+		{parse("+385915125486"), nil, false},
+	}
+	for _, tt := range tests {
+		if got := Equal(tt.a, tt.b); got != tt.want {
+			t.Errorf("Equal(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestMatchNumbers(t *testing.T) {
+	p := newParser(t)
+	tests := []struct {
+		a, b string
+		want MatchType
+	}{
+		{"+385915125486", "+385 91 512 5486", ExactMatch},
+		{"00385 91 512 5486 x148", "+385915125486 ext 148", ExactMatch},
+		{"091 512 5486", "+385915125486", NSNMatch},
+		{"+385915125486", "091/512-5486", NSNMatch},
+		{"091 512 5486", "91 512 5486", NSNMatch},
+		{"00385 91 512 5486 x148", "+385915125486", ShortNSNMatch},
+		{"512 5486", "+385915125486", ShortNSNMatch},
+		{"6", "+385915125486", NoMatch},
+		{"86", "+385915125486", NoMatch},
+		{"25486", "+385915125486", NoMatch},
+		{"5486", "5125486", NoMatch},
+		{"+385915125486 x148", "+385915125486 x149", NoMatch},
+		{"+385915125486", "+49915125486", NoMatch},
+		{"+385915125486", "+385915125487", NoMatch},
+		{"+385915125486", "no number", NoMatch},
+	}
+	for _, tt := range tests {
+		if got := p.MatchNumbers(tt.a, tt.b); got != tt.want {
+			t.Errorf("MatchNumbers(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+		if got := p.MatchNumbers(tt.b, tt.a); got != tt.want {
+			t.Errorf("MatchNumbers(%q, %q) = %v, want %v", tt.b, tt.a, got, tt.want)
+		}
+	}
+}
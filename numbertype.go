@@ -0,0 +1,152 @@
+package phone
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+)
+
+// NumberType is the kind of line a number is allocated to.
+type NumberType int
+
+const (
+	// Unknown means the number matches none of the number_types patterns
+	// of its country, or that the country has no number_types data, as is
+	// the case for most countries in the built-in data.
+	Unknown NumberType = iota
+	FixedLine
+	Mobile
+	// FixedOrMobile is used where fixed-line and mobile numbers cannot be
+	// told apart, as in the NANP.
+	FixedOrMobile
+	TollFree
+	PremiumRate
+	SharedCost
+	VoIP
+	Pager
+	// UAN is a universal access number, reaching a company by one number.
+	UAN
+	Voicemail
+)
+
+// numberTypeKeys maps the keys of number_types in the country data to
+// types, in the order they are tried. Fixed-line and mobile come last since
+// their patterns are the broadest.
+var numberTypeKeys = []struct {
+	key string
+	typ NumberType
+}{
+	{"toll_free", TollFree},
+	{"premium_rate", PremiumRate},
+	{"shared_cost", SharedCost},
+	{"voip", VoIP},
+	{"pager", Pager},
+	{"uan", UAN},
+	{"voicemail", Voicemail},
+	{"mobile", Mobile},
+	{"fixed_line", FixedLine},
+}
+
+func (t NumberType) String() string {
+	switch t {
+	case Unknown:
+		return "Unknown"
+	case FixedLine:
+		return "FixedLine"
+	case Mobile:
+		return "Mobile"
+	case FixedOrMobile:
+		return "FixedOrMobile"
+	case TollFree:
+		return "TollFree"
+	case PremiumRate:
+		return "PremiumRate"
+	case SharedCost:
+		return "SharedCost"
+	case VoIP:
+		return "VoIP"
+	case Pager:
+		return "Pager"
+	case UAN:
+		return "UAN"
+	case Voicemail:
+		return "Voicemail"
+	}
+	return fmt.Sprintf("NumberType(%d)", int(t))
+}
+
+// Type classifies the number by the number_types patterns of its region, or
+// of its country when the region has none. It returns Unknown for numbers of
+// countries without number_types; check len(c.Country().NumberTypes) to
+// tell that from a number matching none of them.
+func (c *Phone) Type() NumberType {
+	country := c.Country()
+	if country == nil {
+		return Unknown
+	}
+	return country.NumberType(c.NationalSignificantNumber())
+}
+
+// NumberType classifies a national significant number by the patterns of
+// the region it belongs to, or of the country when the region has none.
+func (c *Country) NumberType(number string) NumberType {
+	types := c.NumberTypes
+	if r := c.RegionFor(number); r.NumberTypes != nil {
+		types = r.NumberTypes
+	}
+	return classify(types, number)
+}
+
+func classify(types map[string]string, number string) NumberType {
+	for _, k := range numberTypeKeys {
+		exp, found := types[k.key]
+		if !found || !matchWhole(exp, number) {
+			continue
+		}
+		if k.typ == Mobile {
+			if exp, found := types["fixed_line"]; found && matchWhole(exp, number) {
+				return FixedOrMobile
+			}
+		}
+		return k.typ
+	}
+	return Unknown
+}
+
+func validateNumberTypes(types map[string]string) error {
+	for key, exp := range types {
+		found := false
+		for _, k := range numberTypeKeys {
+			found = found || k.key == key
+		}
+		if !found {
+			return fmt.Errorf("unknown number type %q", key)
+		}
+		if _, err := regexp.Compile(exp); err != nil {
+			return fmt.Errorf("invalid %s pattern: %v", key, err)
+		}
+	}
+	return nil
+}
+
+var compiledExps sync.Map
+
+// compiled returns exp compiled. Compiled expressions are cached since the
+// country data patterns are matched over and over.
+func compiled(exp string) *regexp.Regexp {
+	re, found := compiledExps.Load(exp)
+	if !found {
+		re, _ = compiledExps.LoadOrStore(exp, regexp.MustCompile(exp))
+	}
+	return re.(*regexp.Regexp)
+}
+
+// matchWhole reports whether exp matches all of s.
+func matchWhole(exp, s string) bool {
+	return compiled("^(?:" + exp + ")$").MatchString(s)
+}
+
+// matchPrefix reports whether exp matches the start of s.
+func matchPrefix(exp, s string) bool {
+	return compiled("^(?:" + exp + ")").MatchString(s)
+}
@@ -0,0 +1,52 @@
+package phone
+
+import "testing"
+
+func TestType(t *testing.T) {
+	tests := map[string]NumberType{
+		"+385915125486":  Mobile,
+		"+38514812345":   FixedLine,
+		"+385800123456":  TollFree,
+		"+12125550100":   FixedOrMobile,
+		"+18005550100":   TollFree,
+		"+447911123456":  Mobile,
+		"+442079460018":  FixedLine,
+		"+4915112345678": Mobile,
+		"+77011234567":   Mobile,
+		"+77172123456":   FixedLine,
+		"+74951234567":   FixedLine,
+		"+393123456789":  Mobile,
+		"+390612345678":  FixedLine,
+		"+39800123456":   TollFree,
+		"+819012345678":  Mobile,
+		"+81312345678":   FixedLine,
+		"+81120123456":   TollFree,
+		"+34912345678":   FixedLine,
+		"+5511987654321": Unknown,
+	}
+	for input, want := range tests {
+		c, err := Parse(input)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", input, err)
+			continue
+		}
+		if got := c.Type(); got != want {
+			t.Errorf("Parse(%q).Type() = %v, want %v", input, got, want)
+		}
+	}
+
+	c, err := newParser(t, WithDefaultAreaCode("12")).Parse("+993345678")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Type() != Unknown {
+		t.Errorf("Type() without patterns = %v", c.Type())
+	}
+}
+
+func BenchmarkRegionFor(b *testing.B) {
+	us := FindByCountryCode("1")
+	for i := 0; i < b.N; i++ {
+		us.RegionFor("4165550100")
+	}
+}
@@ -0,0 +1,311 @@
+package phone
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const invalidChar = `[^0-9+\-./() \t]`
+
+var (
+	mu            sync.RWMutex
+	defaultParser = &Parser{lenient: true}
+)
+
+// Parser parses phone numbers against its own defaults. A Parser is not
+// modified after NewParser returns, so one value may be shared by many
+// goroutines and several Parsers may coexist in one process.
+type Parser struct {
+	defaultCountryCode string
+	defaultAreaCode    string
+	lenient            bool
+	data               *countryData
+	// err is the first error met by an Option, returned by NewParser.
+	err error
+}
+
+// Option configures a Parser built by NewParser.
+type Option func(*Parser)
+
+// WithDefaultCountryCode sets the country code used when the input has none.
+func WithDefaultCountryCode(code string) Option {
+	return func(p *Parser) {
+		p.defaultCountryCode = code
+	}
+}
+
+// WithDefaultAreaCode sets the area code used when the input has none.
+func WithDefaultAreaCode(code string) Option {
+	return func(p *Parser) {
+		p.defaultAreaCode = code
+	}
+}
+
+// WithLenient controls how forgiving the Parser is. A lenient Parser (the
+// default) drops every character that is not part of the number; a strict
+// one only accepts digits, '+' and the usual visual separators.
+func WithLenient(lenient bool) Option {
+	return func(p *Parser) {
+		p.lenient = lenient
+	}
+}
+
+// WithCountries makes the Parser use countries instead of the built-in
+// country data. The table is copied and validated like LoadCountries does;
+// NewParser returns the error if it is invalid.
+func WithCountries(countries map[string]Country) Option {
+	return func(p *Parser) {
+		c := copyCountries(countries)
+		if err := validateCountries(c); err != nil {
+			if p.err == nil {
+				p.err = err
+			}
+			return
+		}
+		p.data = newCountryData(c)
+	}
+}
+
+// NewParser returns a Parser configured by opts, or the error of the first
+// option that failed.
+func NewParser(opts ...Option) (*Parser, error) {
+	p := &Parser{lenient: true}
+	for _, opt := range opts {
+		opt(p)
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	return p, nil
+}
+
+// DefaultParser returns the Parser used by the package level functions.
+func DefaultParser() *Parser {
+	mu.RLock()
+	defer mu.RUnlock()
+	return defaultParser
+}
+
+// SetDefaultCountryCode sets the default country code of the package
+// default Parser.
+func SetDefaultCountryCode(code string) string {
+	mu.Lock()
+	defer mu.Unlock()
+	p := *defaultParser
+	p.defaultCountryCode = code
+	defaultParser = &p
+	return code
+}
+
+// SetDefaultAreaCode sets the default area code of the package default
+// Parser.
+func SetDefaultAreaCode(code string) string {
+	mu.Lock()
+	defer mu.Unlock()
+	p := *defaultParser
+	p.defaultAreaCode = code
+	defaultParser = &p
+	return code
+}
+
+// DefaultCountryCode returns the country code used when the input has none.
+func (p *Parser) DefaultCountryCode() string {
+	return p.defaultCountryCode
+}
+
+// DefaultAreaCode returns the area code used when the input has none.
+func (p *Parser) DefaultAreaCode() string {
+	return p.defaultAreaCode
+}
+
+// FindByCountryCode finds country by dialing code in the Parser's data.
+func (p *Parser) FindByCountryCode(code string) *Country {
+	return p.countries().findByCode(code)
+}
+
+// DetectCountry is like the package level DetectCountry but uses the
+// Parser's data.
+func (p *Parser) DetectCountry(number string) (*Country, int) {
+	return p.countries().detect(number)
+}
+
+// countries returns the Parser's country data. The package default Parser
+// is built before the built-in data is loaded, so it is looked up lazily.
+func (p *Parser) countries() *countryData {
+	if p.data != nil {
+		return p.data
+	}
+	return builtin
+}
+
+// Parse detects the country code, area code, number and extension in s.
+// Errors are of type *ParseError.
+func (p *Parser) Parse(s string) (*Phone, error) {
+	sub, e := extractExtension(s)
+	if err := p.checkChars(s, sub); err != nil {
+		return nil, err
+	}
+	return p.parseNormalized(s, normalize(sub), e)
+}
+
+// ParseFrom parses s as dialed from the region with the given ISO 3166-1
+// code. The region's international dialing prefix introduces a calling
+// code, so "011 44 20 7946 0018" from the US is a British number; anything
+// else is a national number of the region, with its national prefix
+// dropped.
+func (p *Parser) ParseFrom(s, region string) (*Phone, error) {
+	from := p.countries().findByIsoCode(region)
+	if from == nil {
+		return nil, newParseError(UnknownCountryCode, s, -1)
+	}
+	sub, e := extractExtension(s)
+	if err := p.checkChars(s, sub); err != nil {
+		return nil, err
+	}
+	number := dialedDigits(sub)
+	if !strings.HasPrefix(number, "+") {
+		if ip := from.InternationalPrefix(); ip != "" && strings.HasPrefix(number, ip) {
+			number = "+" + number[len(ip):]
+		} else {
+			number = strings.TrimPrefix(number, from.NationalPrefix())
+			national := *p
+			national.defaultCountryCode = from.CountryCode
+			p = &national
+		}
+	}
+	return p.parseNormalized(s, number, e)
+}
+
+func (p *Parser) checkChars(input, sub string) error {
+	if p.lenient {
+		return nil
+	}
+	if loc := regexp.MustCompile(invalidChar).FindStringIndex(sub); loc != nil {
+		return newParseError(InvalidCharacters, input, loc[0])
+	}
+	return nil
+}
+
+// parseNormalized parses sub, the normalized form of input without its
+// extension ext.
+func (p *Parser) parseNormalized(input, sub, ext string) (*Phone, error) {
+	if digitsOnly(sub) == "" {
+		return nil, newParseError(TooShort, input, -1)
+	}
+	args, reason := p.splitToParts(sub)
+	if reason != 0 {
+		return nil, newParseError(reason, input, reasonOffset(reason))
+	}
+	c, reason := p.build(args)
+	if reason != 0 {
+		return nil, newParseError(reason, input, -1)
+	}
+	if len(digitsOnly(c.CountryCode)+c.NationalSignificantNumber()) > MaxE164Length {
+		return nil, newParseError(TooLong, input, -1)
+	}
+	c.Extension = ext
+	return c, nil
+}
+
+// IsValid reports whether s can be parsed.
+func (p *Parser) IsValid(s string) bool {
+	_, err := p.Parse(s)
+	return err == nil
+}
+
+// New builds a Phone from number, area code, country code and extension,
+// filling missing codes from the Parser defaults. Errors are of type
+// *ParseError; the Phone is returned even then so callers can inspect it.
+func (p *Parser) New(args []string) (*Phone, error) {
+	c, reason := p.build(args)
+	if reason != 0 {
+		return c, newParseError(reason, strings.Join(args, " "), -1)
+	}
+	return c, nil
+}
+
+func (p *Parser) build(args []string) (input *Phone, reason Reason) {
+	input = ArgsToCountry(args...)
+	input.DefaultCountryCode = p.defaultCountryCode
+	input.DefaultAreaCode = p.defaultAreaCode
+
+	if input.N1Length == "" {
+		input.N1Length = "3"
+	}
+
+	if input.CountryCode == "" {
+		input.CountryCode = p.defaultCountryCode
+	}
+
+	// Countries without area_code data have no area codes to default to.
+	input.data = p.countries()
+	country := p.FindByCountryCode(digitsOnly(input.CountryCode))
+	needsAreaCode := country == nil || country.AreaCode != ""
+	if input.AreaCode == "" && needsAreaCode {
+		input.AreaCode = p.defaultAreaCode
+	}
+
+	if country != nil {
+		input.country = country
+		input.Region = country.RegionFor(input.NationalSignificantNumber()).Alpha2
+	}
+
+	if strings.Trim(input.AreaCode, "\t \n") == "" && needsAreaCode {
+		reason = InvalidAreaCode
+	}
+	if strings.Trim(input.Number, "\t \n") == "" {
+		reason = TooShort
+	}
+	if strings.Trim(input.CountryCode, "\t \n") == "" {
+		reason = NoCountry
+	}
+	return input, reason
+}
+
+func (p *Parser) splitToParts(s string) (args []string, reason Reason) {
+	c, reason := p.countries().detectCountry(s, p.defaultCountryCode)
+	if c == nil {
+		return nil, reason
+	}
+
+	// The country code is replaced by a 0 that is stripped along with the
+	// national prefix. Where there is no national prefix, leading zeros are
+	// part of the number, as in Italy.
+	re := c.CountryCodeRegexp()
+	zeros := "0*"
+	if c.NationalPrefix() == "" {
+		s = re.ReplaceAllString(s, "")
+		zeros = ""
+	} else {
+		s = re.ReplaceAllString(s, "0")
+	}
+
+	var areaCode string
+	n := compiled(fmt.Sprintf("^%s(%s)", zeros, c.AreaCode))
+	if m := n.FindStringSubmatch(s); m != nil {
+		areaCode = m[1]
+	}
+	number := n.ReplaceAllString(s, "")
+	if areaCode == "" {
+		// The 0 standing for the country code goes even without an area
+		// code, so "+1" is left with no number rather than "0".
+		number = compiled("^"+zeros).ReplaceAllString(number, "")
+	}
+
+	args = append(args, number)
+	args = append(args, areaCode)
+	args = append(args, "+"+c.CountryCode)
+	return args, 0
+}
+
+// reasonOffset returns the offset reported for problems found after
+// normalization, where positions in the original input are lost. Country
+// code problems are always at the start of the number.
+func reasonOffset(r Reason) int {
+	if r == NoCountry || r == UnknownCountryCode {
+		return 0
+	}
+	return -1
+}
@@ -0,0 +1,177 @@
+package phone
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestParserDefaults(t *testing.T) {
+	hr := newParser(t, WithDefaultCountryCode("385"), WithDefaultAreaCode("47"))
+	de := newParser(t, WithDefaultCountryCode("49"))
+
+	c, err := hr.Parse("451-588")
+	if err != nil {
+		t.Fatalf("hr parse: %v", err)
+	}
+	if c.CountryCode != "+385" {
+		t.Errorf("hr country code is %q", c.CountryCode)
+	}
+
+	c, err = de.Parse("030 1234567")
+	if err != nil {
+		t.Fatalf("de parse: %v", err)
+	}
+	if c.CountryCode != "+49" {
+		t.Errorf("de country code is %q", c.CountryCode)
+	}
+}
+
+func TestParserConcurrent(t *testing.T) {
+	parsers := []*Parser{
+		newParser(t, WithDefaultCountryCode("385")),
+		newParser(t, WithDefaultCountryCode("49")),
+	}
+	want := []string{"+385", "+49"}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		for j, p := range parsers {
+			wg.Add(1)
+			go func(p *Parser, want string) {
+				defer wg.Done()
+				c, err := p.Parse("0915125486")
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if c.CountryCode != want {
+					t.Errorf("country code is %q, want %q", c.CountryCode, want)
+				}
+			}(p, want[j])
+		}
+	}
+	wg.Wait()
+}
+
+func TestParserStrict(t *testing.T) {
+	strict := newParser(t, WithLenient(false))
+	if _, err := strict.Parse("+385 91 512 5486"); err != nil {
+		t.Errorf("strict parse: %v", err)
+	}
+	if strict.IsValid("blabla +385915125486") {
+		t.Error("strict parser accepted letters")
+	}
+	if !newParser(t).IsValid("blabla +385915125486") {
+		t.Error("lenient parser rejected letters")
+	}
+}
+
+func TestParseFrom(t *testing.T) {
+	tests := []struct {
+		input, region, want string
+	}{
+		{"011 44 20 7946 0018", "US", "+442079460018"},
+		{"(212) 555-0100", "US", "+12125550100"},
+		{"1 212 555 0100", "US", "+12125550100"},
+		{"00 385 91 512 5486", "DE", "+385915125486"},
+		{"091/512-5486", "HR", "+385915125486"},
+		{"810 385 91 512 5486", "TJ", "+385915125486"},
+		{"8 (495) 123-45-67", "RU", "+74951234567"},
+		{"+385 91 512 5486", "US", "+385915125486"},
+		{"06 1 234 5678", "HU", "+3612345678"},
+		{"2123 4567", "MT", "+35621234567"},
+		{"5 123 4567", "CO", "+5751234567"},
+		{"01 234 5678", "CO", "+5712345678"},
+		{"021 23 45 67", "DZ", "+21321234567"},
+		{"011 2345 6789", "BR", "+551123456789"},
+		{"02 212 3456", "BO", "+59122123456"},
+	}
+	p := newParser(t)
+	for _, tt := range tests {
+		c, err := p.ParseFrom(tt.input, tt.region)
+		if err != nil {
+			t.Errorf("ParseFrom(%q, %q): %v", tt.input, tt.region, err)
+			continue
+		}
+		if got := c.E164(); got != tt.want {
+			t.Errorf("ParseFrom(%q, %q) = %q, want %q", tt.input, tt.region, got, tt.want)
+		}
+	}
+	if _, err := ParseFrom("091 512 5486", "XX"); err == nil {
+		t.Error("ParseFrom accepted an unknown region")
+	}
+}
+
+func TestNationalPrefixes(t *testing.T) {
+	tests := map[string]string{
+		"HR": "0", "HU": "06", "RU": "8", "US": "1",
+		"DZ": "0", "BR": "0", "BO": "0", "CO": "0",
+		"MT": "", "LR": "", "MX": "",
+	}
+	for region, want := range tests {
+		c := FindByCountryIsoCode(region)
+		if c == nil {
+			t.Errorf("no country %s", region)
+			continue
+		}
+		if got := c.NationalPrefix(); got != want {
+			t.Errorf("%s national prefix = %q, want %q", region, got, want)
+		}
+	}
+}
+
+func TestParseInternationalPrefix(t *testing.T) {
+	tests := []struct {
+		input, cc, want string
+	}{
+		{"00385 91 512 5486", "", "+385915125486"},
+		{"00385915125486", "", "+385915125486"},
+		{"+00385915125486", "", "+385915125486"},
+		{"+385 91 512 5486 x148", "", "+385915125486"},
+		{"+385 (0)91 512 5486", "", "+385915125486"},
+		{"+44 (0) 20 7946 0018", "", "+442079460018"},
+		{"091 512 5486", "385", "+385915125486"},
+		{"06 1234 5678", "39", "+390612345678"},
+		{"0039 06 1234 5678", "39", "+390612345678"},
+		{"+39 06 1234 5678", "", "+390612345678"},
+	}
+	for _, tt := range tests {
+		c, err := newParser(t, WithDefaultCountryCode(tt.cc)).Parse(tt.input)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.input, err)
+			continue
+		}
+		if got := c.E164(); got != tt.want {
+			t.Errorf("Parse(%q) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+}
+
+// newParser is NewParser failing the test on error.
+func newParser(t *testing.T, opts ...Option) *Parser {
+	t.Helper()
+	p, err := NewParser(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestParseWithoutAreaCodeData(t *testing.T) {
+	tests := map[string]string{
+		"+39 06 1234 5678": "+390612345678",
+		"+81 3 1234 5678":  "+81312345678",
+		"+356 2123 4567":   "+35621234567",
+	}
+	p := newParser(t, WithDefaultAreaCode("47"))
+	for input, want := range tests {
+		c, err := p.Parse(input)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", input, err)
+			continue
+		}
+		if got := c.E164(); got != want {
+			t.Errorf("Parse(%q) = %s, want %s", input, got, want)
+		}
+	}
+}
@@ -1,23 +1,23 @@
 package phone
 
 import (
-	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
-	"sync"
 )
 
 const (
 	commonExtensions = `(ext|ex|x|xt|#|:)+[^0-9]*([-0-9]{1,})*#?$`
 	commonNumber     = `[0-9]{1,}$`
-	commonExtras     = `(\(0\)|[^0-9+]|^\+?00?)`
+	commonExtras     = `\(0\)|[^0-9+]`
 	formatTokens     = `(%[caAnflx])`
+
+	// commonPrefixes are the ways of writing "+" before a calling code.
+	commonPrefixes = `^(?:\+0{1,2}|00)([1-9])`
 )
 
 var (
-	mu          sync.Mutex
 	fmtEnum     = []string{"default", "default_with_extension", "europe", "us"}
 	namedFormat = map[string]string{
 		"default":                "+%c%a%n",
@@ -25,76 +25,47 @@ var (
 		"europe":                 "+%c (0) %a %f %l",
 		"us":                     "(%a) %f-%l",
 	}
-	commonExtraReplacements = map[string]string{
-		"(0)": "+",
-		"00":  "+",
-		"+00": "+",
-		"+0":  "+",
-	}
-	defaultCountryCode string
-	defaultAreaCode    string
 )
 
 type Phone struct {
-	NamedFormats       string
-	N1Length           string
-	Number             string `yaml:"number"`
-	CountryCode        string `yaml:"country_code"`
-	AreaCode           string `yaml:"area_code"`
-	Extension          string `yaml:"extension"`
+	NamedFormats string
+	N1Length     string
+	Number       string `yaml:"number"`
+	CountryCode  string `yaml:"country_code"`
+	AreaCode     string `yaml:"area_code"`
+	Extension    string `yaml:"extension"`
+	// Subaddress is the ISDN subaddress of numbers from tel: URIs.
+	Subaddress string `yaml:"subaddress"`
+	// Region is the ISO 3166-1 alpha-2 code of the region the number is
+	// allocated to, e.g. "CA" for +1 416 ...
+	Region             string `yaml:"region"`
 	DefaultCountryCode string
 	DefaultAreaCode    string
+
+	// country is the data the number was parsed against.
+	country *Country
+	// data is the country table of the Parser that built the number.
+	data *countryData
 }
 
+// Parse parses s using the package default Parser.
 func Parse(s string) (*Phone, error) {
-	if s == "" {
-		return nil, nil
-	}
-	sub, e := extractExtension(s)
-	sub = normalize(sub)
-	args, err := splitToParts(sub)
-	if err != nil {
-		return nil, err
-	}
-	c, err := New(args)
-	if err != nil {
-		return nil, err
-	}
-	c.Extension = e
-	return c, nil
+	return DefaultParser().Parse(s)
 }
 
-func IsValid(s string) bool {
-	_, err := Parse(s)
-	return err == nil
+// ParseFrom parses s as dialed from region using the package default Parser.
+func ParseFrom(s, region string) (*Phone, error) {
+	return DefaultParser().ParseFrom(s, region)
 }
 
-func New(args []string) (input *Phone, err error) {
-	input = ArgsToCountry(args...)
-
-	if input.N1Length == "" {
-		input.N1Length = "3"
-	}
-
-	if input.CountryCode == "" {
-		input.CountryCode = defaultCountryCode
-	}
-
-	if input.AreaCode == "" {
-		input.AreaCode = defaultAreaCode
-	}
-
-	if strings.Trim(input.Number, "\t \n") == "" {
-		err = errors.New("must enter number")
-	}
-	if strings.Trim(input.AreaCode, "\t \n") == "" {
-		err = errors.New("must enter area code or set default")
-	}
-	if strings.Trim(input.CountryCode, "\t \n") == "" {
-		err = errors.New("must enter country code or set default")
-	}
+// IsValid reports whether s can be parsed by the package default Parser.
+func IsValid(s string) bool {
+	return DefaultParser().IsValid(s)
+}
 
-	return input, err
+// New builds a Phone from its parts using the package default Parser.
+func New(args []string) (*Phone, error) {
+	return DefaultParser().New(args)
 }
 
 func ArgsToCountry(args ...string) *Phone {
@@ -118,6 +89,23 @@ func ArgsToCountry(args ...string) *Phone {
 	return c
 }
 
+// Country returns the country data of the number's calling code, as used
+// by the Parser that built it.
+func (c *Phone) Country() *Country {
+	if c.country != nil {
+		return c.country
+	}
+	return c.countries().findByCode(digitsOnly(c.CountryCode))
+}
+
+// countries returns the country table the number was built with.
+func (c *Phone) countries() *countryData {
+	if c.data != nil {
+		return c.data
+	}
+	return builtin
+}
+
 func (c *Phone) String() string {
 	return c.Format("default")
 }
@@ -128,6 +116,9 @@ func (c *Phone) Number1() string {
 	if err != nil {
 		panic(err)
 	}
+	if i > len(data) {
+		i = len(data)
+	}
 	str := string(data[0:i])
 
 	return str
@@ -139,6 +130,9 @@ func (c *Phone) Number2() string {
 		panic(err)
 	}
 	l := len(data) - i - 1
+	if l < 0 {
+		l = 0
+	}
 	str := string(data[l:])
 
 	return str
@@ -180,20 +174,6 @@ func (c *Phone) FormatNumber(fm string) string {
 	return fm
 }
 
-func SetDefaultCountryCode(code string) string {
-	mu.Lock()
-	defer mu.Unlock()
-	defaultCountryCode = code
-	return code
-}
-
-func SetDefaultAreaCode(code string) string {
-	mu.Lock()
-	defer mu.Unlock()
-	defaultAreaCode = code
-	return code
-}
-
 func extractExtension(s string) (string, string) {
 	re := regexp.MustCompile(commonExtensions)
 	subbed := re.FindString(s)
@@ -206,46 +186,22 @@ func extractExtension(s string) (string, string) {
 	}
 }
 
+// normalize drops the common extras from stringWithNumber, including the
+// "(0)" of "+44 (0) 20 ...", and writes an international prefix before a
+// calling code as "+". A single leading 0 is a national prefix or, as in
+// Italy, part of the number, and is kept.
 func normalize(stringWithNumber string) string {
-	re := regexp.MustCompile(commonExtras)
-	match := re.FindAllString(stringWithNumber, -1)
-	var s string
-	for _, m := range match {
-		s = commonExtraReplacements[m]
-		stringWithNumber = re.ReplaceAllString(stringWithNumber, s)
-	}
-	return stringWithNumber
+	s := compiled(commonExtras).ReplaceAllString(stringWithNumber, "")
+	return compiled(commonPrefixes).ReplaceAllString(s, "+$1")
 }
 
-func splitToParts(s string) (args []string, err error) {
-	c := detectCountry(s, defaultCountryCode)
-
-	if c != nil {
-		re := c.CountryCodeRegexp()
-		s = re.ReplaceAllString(s, "0")
-		c.CountryCode = "+" + c.CountryCode
+// dialedDigits keeps the digits of s and a leading "+".
+func dialedDigits(s string) string {
+	d := digitsOnly(s)
+	if strings.HasPrefix(strings.TrimSpace(s), "+") {
+		return "+" + d
 	}
-
-	if c == nil {
-		err = errors.New("must specify country code")
-		return nil, err
-	}
-
-	format := c.DetectFormat(s)
-	if format == "" {
-		return nil, err
-	}
-
-	r, _ := regexp.Compile(c.AreaCode)
-	areaCode := r.FindString(s)
-
-	n, _ := regexp.Compile(fmt.Sprintf("^0*(%s)", c.AreaCode))
-	number := n.ReplaceAllString(s, "")
-
-	args = append(args, number)
-	args = append(args, areaCode)
-	args = append(args, c.CountryCode)
-	return args, nil
+	return d
 }
 
 func removeUselessPlus(s string) string {
@@ -261,4 +217,4 @@ func contains(s []string, str string) bool {
 		}
 	}
 	return false
-}
\ No newline at end of file
+}
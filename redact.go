@@ -0,0 +1,258 @@
+package phone
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"regexp"
+	"strings"
+)
+
+// RedactStyle selects how redaction hides the numbers it finds.
+type RedactStyle int
+
+const (
+	// RedactPartial writes the number in international format keeping the
+	// country code, the first group and the last digits, e.g.
+	// "+385 91 *** **86".
+	RedactPartial RedactStyle = iota
+	// RedactDigits masks every digit of the number as written.
+	RedactDigits
+	// RedactPlaceholder replaces the number with a placeholder.
+	RedactPlaceholder
+)
+
+// digitGroupExp matches the blocks of digits of a formatted number, e.g.
+// "1", "212", "555" and "0100" in "+1 212-555-0100".
+var digitGroupExp = regexp.MustCompile(`[0-9]+`)
+
+// maxRedactLine bounds how much RedactWriter buffers while waiting for the
+// end of a line.
+const maxRedactLine = 64 << 10
+
+// RedactOptions configures Redact, RedactWriter and the slog helpers.
+type RedactOptions struct {
+	// Parser finds the numbers; nil means the package default Parser.
+	Parser *Parser
+	// Region is the region numbers are written from, as for FindNumbers.
+	Region string
+	Style  RedactStyle
+	// Mask replaces hidden digits; zero means '*'.
+	Mask rune
+	// KeepLast is the number of final digits RedactPartial keeps; zero
+	// means 2, negative means none.
+	KeepLast int
+	// Placeholder is written by RedactPlaceholder; empty means "[phone]".
+	Placeholder string
+}
+
+func (o *RedactOptions) parser() *Parser {
+	if o.Parser != nil {
+		return o.Parser
+	}
+	return DefaultParser()
+}
+
+func (o *RedactOptions) mask() rune {
+	if o.Mask != 0 {
+		return o.Mask
+	}
+	return '*'
+}
+
+func (o *RedactOptions) keepLast() int {
+	switch {
+	case o.KeepLast < 0:
+		return 0
+	case o.KeepLast == 0:
+		return 2
+	}
+	return o.KeepLast
+}
+
+// Redact returns text with the numbers in it hidden. It finds numbers like
+// FindNumbers but hides every one that parses to a possible number, even
+// those Validate rejects for want of data, since leaking a number is worse
+// than hiding something that only looks like one. For the same reason,
+// runs of 6 to 15 digits that do not parse, such as national numbers when
+// neither Region nor a default country code says where they are from, have
+// their digits masked.
+func Redact(text string, opts RedactOptions) string {
+	matches := opts.parser().findNumbers(text, opts.Region, (*Phone).IsPossible, true)
+	if len(matches) == 0 {
+		return text
+	}
+	var b strings.Builder
+	last := 0
+	for _, m := range matches {
+		b.WriteString(text[last:m.Start])
+		b.WriteString(opts.redact(m))
+		last = m.End
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+func (o *RedactOptions) redact(m Match) string {
+	switch o.Style {
+	case RedactDigits:
+		return maskDigits(m.Raw, 0, 0, o.mask())
+	case RedactPlaceholder:
+		if o.Placeholder != "" {
+			return o.Placeholder
+		}
+		return "[phone]"
+	}
+	if m.Phone == nil {
+		return maskDigits(m.Raw, 0, o.keepLast(), o.mask())
+	}
+	s := m.Phone.FormatInternational()
+	keep := len(digitsOnly(m.Phone.CountryCode))
+	if groups := digitGroupExp.FindAllString(s, -1); len(groups) > 2 {
+		keep += len(groups[1])
+	}
+	return maskDigits(s, keep, o.keepLast(), o.mask())
+}
+
+// maskDigits replaces the digits of s with mask, except for the first
+// keepFirst and the last keepLast.
+func maskDigits(s string, keepFirst, keepLast int, mask rune) string {
+	n := len(digitsOnly(s))
+	var b strings.Builder
+	i := 0
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			if i >= keepFirst && i < n-keepLast {
+				r = mask
+			}
+			i++
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// RedactWriter returns a writer redacting the text written to it before
+// passing it on to w. Text is passed on a line at a time so numbers split
+// across writes are still found; Close writes out the last partial line
+// without closing w.
+func RedactWriter(w io.Writer, opts RedactOptions) io.WriteCloser {
+	return &redactWriter{w: w, opts: opts}
+}
+
+type redactWriter struct {
+	w    io.Writer
+	opts RedactOptions
+	buf  []byte
+}
+
+func (rw *redactWriter) Write(p []byte) (int, error) {
+	rw.buf = append(rw.buf, p...)
+	end := bytes.LastIndexByte(rw.buf, '\n') + 1
+	if end == 0 && len(rw.buf) > maxRedactLine {
+		end = bytes.LastIndexAny(rw.buf, " \t") + 1
+		if end == 0 {
+			end = len(rw.buf)
+		}
+	}
+	if end == 0 {
+		return len(p), nil
+	}
+	// p is buffered either way, so report it as written: a caller
+	// retrying after an error would otherwise write it twice.
+	return len(p), rw.flush(end)
+}
+
+func (rw *redactWriter) flush(end int) error {
+	_, err := io.WriteString(rw.w, Redact(string(rw.buf[:end]), rw.opts))
+	rw.buf = append(rw.buf[:0], rw.buf[end:]...)
+	return err
+}
+
+// Close writes out the buffered partial line.
+func (rw *redactWriter) Close() error {
+	if len(rw.buf) == 0 {
+		return nil
+	}
+	return rw.flush(len(rw.buf))
+}
+
+// RedactAttr returns a function for slog.HandlerOptions.ReplaceAttr that
+// redacts string values, the text of errors and fmt.Stringers, and the JSON
+// (or, failing that, fmt) rendering of any other value such as slices, maps
+// and structs.
+func RedactAttr(opts RedactOptions) func(groups []string, a slog.Attr) slog.Attr {
+	return func(groups []string, a slog.Attr) slog.Attr {
+		return opts.redactAttr(a)
+	}
+}
+
+func (o *RedactOptions) redactAttr(a slog.Attr) slog.Attr {
+	v := a.Value.Resolve()
+	switch v.Kind() {
+	case slog.KindString:
+		a.Value = slog.StringValue(Redact(v.String(), *o))
+	case slog.KindGroup:
+		attrs := v.Group()
+		redacted := make([]slog.Attr, len(attrs))
+		for i, ga := range attrs {
+			redacted[i] = o.redactAttr(ga)
+		}
+		a.Value = slog.GroupValue(redacted...)
+	case slog.KindAny:
+		switch x := v.Any().(type) {
+		case error:
+			a.Value = slog.StringValue(Redact(x.Error(), *o))
+		case fmt.Stringer:
+			a.Value = slog.StringValue(Redact(x.String(), *o))
+		default:
+			s := fmt.Sprintf("%+v", x)
+			if b, err := json.Marshal(x); err == nil {
+				s = string(b)
+			}
+			if r := Redact(s, *o); r != s {
+				a.Value = slog.StringValue(r)
+			}
+		}
+	}
+	return a
+}
+
+// NewRedactHandler returns a slog.Handler redacting the message and
+// attributes of records before handing them to h.
+func NewRedactHandler(h slog.Handler, opts RedactOptions) slog.Handler {
+	return &redactHandler{h: h, opts: opts}
+}
+
+type redactHandler struct {
+	h    slog.Handler
+	opts RedactOptions
+}
+
+func (rh *redactHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return rh.h.Enabled(ctx, level)
+}
+
+func (rh *redactHandler) Handle(ctx context.Context, r slog.Record) error {
+	redacted := slog.NewRecord(r.Time, r.Level, Redact(r.Message, rh.opts), r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		redacted.AddAttrs(rh.opts.redactAttr(a))
+		return true
+	})
+	return rh.h.Handle(ctx, redacted)
+}
+
+func (rh *redactHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		redacted[i] = rh.opts.redactAttr(a)
+	}
+	return &redactHandler{h: rh.h.WithAttrs(redacted), opts: rh.opts}
+}
+
+func (rh *redactHandler) WithGroup(name string) slog.Handler {
+	return &redactHandler{h: rh.h.WithGroup(name), opts: rh.opts}
+}
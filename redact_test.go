@@ -0,0 +1,148 @@
+package phone
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestRedact(t *testing.T) {
+	text := "call +385 91 512 5486 or 091/512-5486 now"
+	tests := []struct {
+		opts RedactOptions
+		want string
+	}{
+		{RedactOptions{Region: "HR"}, "call +385 91 *** **86 or +385 91 *** **86 now"},
+		{RedactOptions{Region: "HR", Style: RedactDigits}, "call +*** ** *** **** or ***/***-**** now"},
+		{RedactOptions{Region: "HR", Style: RedactPlaceholder}, "call [phone] or [phone] now"},
+		{RedactOptions{Region: "HR", Mask: 'x', KeepLast: 4}, "call +385 91 xxx 5486 or +385 91 xxx 5486 now"},
+	}
+	for _, tt := range tests {
+		if got := Redact(text, tt.opts); got != tt.want {
+			t.Errorf("Redact(%+v) = %q, want %q", tt.opts, got, tt.want)
+		}
+	}
+}
+
+func TestRedactWriter(t *testing.T) {
+	var out bytes.Buffer
+	w := RedactWriter(&out, RedactOptions{Region: "HR", Style: RedactPlaceholder})
+	for _, part := range []string{"first +385 91 ", "512 5486\nsecond 091", "/512-5486"} {
+		if _, err := w.Write([]byte(part)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := out.String(); got != "first [phone]\n" {
+		t.Errorf("before Close wrote %q", got)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if got := out.String(); got != "first [phone]\nsecond [phone]" {
+		t.Errorf("wrote %q", got)
+	}
+}
+
+func TestRedactHandler(t *testing.T) {
+	var out bytes.Buffer
+	opts := RedactOptions{Region: "HR"}
+	h := NewRedactHandler(slog.NewTextHandler(&out, nil), opts)
+	log := slog.New(h).With("caller", "+385915125486")
+	log.Info("sent code to 091 512 5486",
+		"err", errors.New("no answer at +385 91 512 5486"),
+		slog.Group("to", "number", "0915125486"))
+	got := out.String()
+	if strings.Contains(got, "5125486") || strings.Contains(got, "512 5486") {
+		t.Errorf("number leaked: %s", got)
+	}
+	if strings.Count(got, "+385 91 *** **86") != 4 {
+		t.Errorf("log line: %s", got)
+	}
+
+	out.Reset()
+	log = slog.New(slog.NewTextHandler(&out, &slog.HandlerOptions{ReplaceAttr: RedactAttr(opts)}))
+	log.Info("login", "phone", "+385 91 512 5486")
+	if !strings.Contains(out.String(), `phone="+385 91 *** **86"`) {
+		t.Errorf("log line: %s", out.String())
+	}
+}
+
+func TestRedactPossibleNumbers(t *testing.T) {
+	opts := RedactOptions{Parser: newParser(t), Region: "HR", Style: RedactPlaceholder}
+	tests := map[string]string{
+		"a 0915125486 0915125487 b":           "a [phone] [phone] b",
+		"Rome +39 06 1234 5678":               "Rome [phone]",
+		"Tokyo +81 3 1234 5678":               "Tokyo [phone]",
+		"on 2026-10-18 at 10:30":              "on 2026-10-18 at 10:30",
+		"NY +1 212 555 0100, HR 091 512 5486": "NY [phone], HR [phone]",
+	}
+	for in, want := range tests {
+		if got := Redact(in, opts); got != want {
+			t.Errorf("Redact(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestRedactPartialKeepsFirstGroup(t *testing.T) {
+	got := Redact("call +1 212 555 0100", RedactOptions{Parser: newParser(t)})
+	if want := "call +1 212-***-**00"; got != want {
+		t.Errorf("Redact = %q, want %q", got, want)
+	}
+}
+
+type failingWriter struct{ fail bool }
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if w.fail {
+		return 0, errors.New("disk full")
+	}
+	return len(p), nil
+}
+
+func TestRedactWriterError(t *testing.T) {
+	w := RedactWriter(&failingWriter{fail: true}, RedactOptions{Region: "HR"})
+	n, err := w.Write([]byte("line\n"))
+	if err == nil || n != 5 {
+		t.Errorf("Write = %d, %v, want 5 and an error", n, err)
+	}
+}
+
+func TestRedactAttrAny(t *testing.T) {
+	var out bytes.Buffer
+	opts := RedactOptions{Region: "HR", Style: RedactPlaceholder}
+	log := slog.New(slog.NewJSONHandler(&out, &slog.HandlerOptions{ReplaceAttr: RedactAttr(opts)}))
+	log.Info("contacts",
+		slog.Any("phones", []string{"+385915125486", "0915125487"}),
+		slog.Any("byName", map[string]string{"ana": "+385 91 512 5486"}),
+		slog.Any("contact", struct{ Phone string }{"091 512 5486"}),
+		slog.Any("count", []int{1, 2}))
+	got := out.String()
+	if strings.Contains(got, "512") {
+		t.Errorf("number leaked: %s", got)
+	}
+	if !strings.Contains(got, `"count":[1,2]`) {
+		t.Errorf("value without numbers was changed: %s", got)
+	}
+}
+
+func TestRedactWithoutRegion(t *testing.T) {
+	opts := RedactOptions{Parser: newParser(t)}
+	tests := map[string]string{
+		"blabla 091/512-5486":        "blabla ***/***-**86",
+		"call 212-555-0100 now":      "call ***-***-**00 now",
+		"+385 91 512 5486":           "+385 91 *** **86",
+		"on 2026-10-18, id 12345":    "on 2026-10-18, id 12345",
+		"ref 1234567890123456789012": "ref 1234567890123456789012",
+	}
+	for in, want := range tests {
+		if got := Redact(in, opts); got != want {
+			t.Errorf("Redact(%q) = %q, want %q", in, got, want)
+		}
+	}
+	opts.Style = RedactPlaceholder
+	if got := Redact("a 0915125486 0915125487 b", opts); got != "a [phone] [phone] b" {
+		t.Errorf("Redact = %q", got)
+	}
+}
@@ -0,0 +1,75 @@
+package phone
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Value implements driver.Valuer, storing the number as its E.164 string
+// followed by "x" and the extension if it has one, as MarshalText does. A
+// Phone without country code or number, the zero Phone included, is an
+// error of type *ParseError, as Scan could not read it back; store a
+// NullPhone for missing numbers.
+func (c Phone) Value() (driver.Value, error) {
+	if err := c.checkParts(); err != nil {
+		return nil, err
+	}
+	text, err := c.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+// Scan implements sql.Scanner by parsing a string or []byte column with the
+// package default Parser, so area code, country and region are derived as
+// Parse does. c is left unchanged on error. NULL is an error; scan nullable
+// columns into a NullPhone.
+func (c *Phone) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case nil:
+		return fmt.Errorf("phone: cannot scan NULL into Phone, use NullPhone")
+	default:
+		return fmt.Errorf("phone: cannot scan %T into Phone", src)
+	}
+	parsed, err := Parse(s)
+	if err != nil {
+		return fmt.Errorf("phone: scanning column: %w", err)
+	}
+	*c = *parsed
+	return nil
+}
+
+// NullPhone is a Phone that may be NULL in the database, like
+// sql.NullString.
+type NullPhone struct {
+	Phone Phone
+	// Valid is true if Phone is not NULL.
+	Valid bool
+}
+
+// Scan implements sql.Scanner.
+func (n *NullPhone) Scan(src interface{}) error {
+	if src == nil {
+		*n = NullPhone{}
+		return nil
+	}
+	if err := n.Phone.Scan(src); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (n NullPhone) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Phone.Value()
+}
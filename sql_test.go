@@ -0,0 +1,84 @@
+package phone
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+)
+
+var (
+	_ sql.Scanner   = (*Phone)(nil)
+	_ driver.Valuer = Phone{}
+	_ sql.Scanner   = (*NullPhone)(nil)
+	_ driver.Valuer = NullPhone{}
+)
+
+func TestPhoneValueScan(t *testing.T) {
+	c, err := newParser(t).Parse("+385915125486 x148")
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := c.Value()
+	if err != nil || v != "+385915125486x148" {
+		t.Fatalf("Value() = %v, %v", v, err)
+	}
+	for _, src := range []interface{}{v, []byte(v.(string))} {
+		var got Phone
+		if err := got.Scan(src); err != nil {
+			t.Fatalf("Scan(%v): %v", src, err)
+		}
+		if got.E164() != c.E164() || got.AreaCode != "91" || got.Region != "HR" || digitsOnly(got.Extension) != "148" {
+			t.Errorf("Scan(%v) = %+v", src, got)
+		}
+	}
+}
+
+func TestPhoneValueIncomplete(t *testing.T) {
+	for _, c := range []Phone{{}, {CountryCode: "385"}, {AreaCode: "91", Number: "5125486"}} {
+		v, err := c.Value()
+		var pe *ParseError
+		if v != nil || !errors.As(err, &pe) {
+			t.Errorf("Value() of %+v = %v, %v", c, v, err)
+		}
+	}
+	if _, err := (Phone{}).Value(); !errors.Is(err, ErrNoCountry) {
+		t.Errorf("Value() of the zero Phone = %v", err)
+	}
+}
+
+func TestPhoneScanErrors(t *testing.T) {
+	c, err := newParser(t).Parse("+385915125486")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := *c
+	if err := c.Scan("+999123"); !errors.Is(err, ErrUnknownCountryCode) {
+		t.Errorf("Scan(+999123) = %v", err)
+	}
+	if *c != want {
+		t.Errorf("failed Scan changed the Phone to %+v", c)
+	}
+	if err := c.Scan(nil); err == nil {
+		t.Error("Scan(nil): no error")
+	}
+	if err := c.Scan(int64(385915125486)); err == nil {
+		t.Error("Scan(int64): no error")
+	}
+}
+
+func TestNullPhone(t *testing.T) {
+	var n NullPhone
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Errorf("Scan(nil) = %v, Valid %v", err, n.Valid)
+	}
+	if v, err := n.Value(); v != nil || err != nil {
+		t.Errorf("Value() of NULL = %v, %v", v, err)
+	}
+	if err := n.Scan("+442079460018"); err != nil || !n.Valid || n.Phone.Region != "GB" {
+		t.Errorf("Scan = %v, %+v", err, n)
+	}
+	if v, err := n.Value(); v != "+442079460018" || err != nil {
+		t.Errorf("Value() = %v, %v", v, err)
+	}
+}
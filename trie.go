@@ -0,0 +1,80 @@
+package phone
+
+// codeTrie is a prefix tree over calling codes, one level per digit.
+type codeTrie struct {
+	children [10]*codeTrie
+	// code is the calling code ending at this node, if any.
+	code string
+}
+
+func newCodeTrie(countries map[string]Country) *codeTrie {
+	t := &codeTrie{}
+	for code := range countries {
+		t.insert(code)
+	}
+	return t
+}
+
+func (t *codeTrie) insert(code string) {
+	n := t
+	for i := 0; i < len(code); i++ {
+		d := code[i] - '0'
+		if d > 9 {
+			return
+		}
+		if n.children[d] == nil {
+			n.children[d] = &codeTrie{}
+		}
+		n = n.children[d]
+	}
+	n.code = code
+}
+
+// longestPrefix returns the longest calling code that digits starts with,
+// or "" if there is none.
+func (t *codeTrie) longestPrefix(digits string) string {
+	var code string
+	n := t
+	for i := 0; i < len(digits); i++ {
+		d := digits[i] - '0'
+		if d > 9 || n.children[d] == nil {
+			break
+		}
+		n = n.children[d]
+		if n.code != "" {
+			code = n.code
+		}
+	}
+	return code
+}
+
+// resolve returns the calling code digits starts with once no longer code
+// can still follow as more digits are added. It returns done false while
+// the code is ambiguous, and "" with done true when digits start with no
+// code.
+func (t *codeTrie) resolve(digits string) (code string, done bool) {
+	n := t
+	for i := 0; i < len(digits); i++ {
+		d := digits[i] - '0'
+		if d > 9 || n.children[d] == nil {
+			return code, true
+		}
+		n = n.children[d]
+		if n.code != "" {
+			code = n.code
+			if n.isLeaf() {
+				return code, true
+			}
+		}
+	}
+	return "", false
+}
+
+func (t *codeTrie) isLeaf() bool {
+	for _, c := range t.children {
+		if c != nil {
+			return false
+		}
+	}
+	return true
+}
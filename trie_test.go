@@ -0,0 +1,55 @@
+package phone
+
+import "testing"
+
+func TestCodeTrieLongestPrefix(t *testing.T) {
+	trie := newCodeTrie(map[string]Country{"1": {}, "1242": {}, "35": {}, "358": {}})
+	tests := map[string]string{
+		"12425551234": "1242",
+		"12125550100": "1",
+		"358401234":   "358",
+		"351234":      "35",
+		"999":         "",
+		"":            "",
+	}
+	for digits, want := range tests {
+		if got := trie.longestPrefix(digits); got != want {
+			t.Errorf("longestPrefix(%q) = %q, want %q", digits, got, want)
+		}
+	}
+}
+
+func TestCodeTrieResolve(t *testing.T) {
+	trie := newCodeTrie(map[string]Country{"1": {}, "1242": {}, "35": {}, "358": {}, "44": {}})
+	tests := []struct {
+		digits string
+		code   string
+		done   bool
+	}{
+		{"4", "", false},
+		{"44", "44", true},
+		{"1", "", false},
+		{"12", "", false},
+		{"121", "1", true},
+		{"1242", "1242", true},
+		{"35", "", false},
+		{"351", "35", true},
+		{"358", "358", true},
+		{"9", "", true},
+	}
+	for _, tt := range tests {
+		if code, done := trie.resolve(tt.digits); code != tt.code || done != tt.done {
+			t.Errorf("resolve(%q) = %q, %v, want %q, %v", tt.digits, code, done, tt.code, tt.done)
+		}
+	}
+}
+
+func TestDetectCountry(t *testing.T) {
+	c, n := DetectCountry("+385915125486")
+	if c == nil || c.CountryCode != "385" || n != 3 {
+		t.Errorf("DetectCountry = %v, %d", c, n)
+	}
+	if c, n := DetectCountry("+999"); c != nil || n != 0 {
+		t.Errorf("DetectCountry(+999) = %v, %d", c, n)
+	}
+}
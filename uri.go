@@ -0,0 +1,193 @@
+package phone
+
+import (
+	"net/url"
+	"strings"
+)
+
+const telScheme = "tel:"
+
+// visualSeparators are the characters RFC 3966 allows between digits.
+const visualSeparators = "-.()"
+
+// ParseTelURI parses an RFC 3966 tel: URI with the package default Parser.
+func ParseTelURI(uri string) (*Phone, error) {
+	return DefaultParser().ParseTelURI(uri)
+}
+
+// ParseTelURI parses an RFC 3966 tel: URI such as
+// "tel:+385-91-512-5486;ext=148". Local numbers take their country from a
+// phone-context holding a global number prefix, or from the Parser default
+// when the context is a domain name. The ext and isub parameters fill
+// Extension and Subaddress; other parameters are ignored.
+func (p *Parser) ParseTelURI(uri string) (*Phone, error) {
+	if !hasSchemeFold(uri, telScheme) {
+		return nil, newParseError(InvalidURI, uri, 0)
+	}
+	parts := strings.Split(uri[len(telScheme):], ";")
+	var ext, isub, context string
+	offset := len(telScheme) + len(parts[0])
+	for _, param := range parts[1:] {
+		offset++
+		name, value := param, ""
+		if i := strings.IndexByte(param, '='); i >= 0 {
+			name, value = param[:i], param[i+1:]
+		}
+		v, err := url.PathUnescape(value)
+		if err != nil {
+			return nil, newParseError(InvalidURI, uri, offset)
+		}
+		switch strings.ToLower(name) {
+		case "ext":
+			ext = v
+		case "isub":
+			isub = v
+		case "phone-context":
+			context = v
+		}
+		offset += len(param)
+	}
+
+	number, i := stripSeparators(parts[0])
+	if i >= 0 {
+		return nil, newParseError(InvalidCharacters, uri, len(telScheme)+i)
+	}
+	if number == "" || number == "+" {
+		return nil, newParseError(TooShort, uri, -1)
+	}
+	if ext != "" {
+		if ext, i = stripSeparators(ext); i >= 0 || strings.HasPrefix(ext, "+") {
+			return nil, newParseError(InvalidURI, uri, strings.Index(uri, ";ext=")+1)
+		}
+	}
+
+	var c *Phone
+	var err error
+	switch {
+	case strings.HasPrefix(number, "+"):
+		c, err = p.parseNormalized(uri, number, ext)
+	case context == "":
+		// RFC 3966 section 5.1.5: local numbers must have a context.
+		return nil, newParseError(InvalidURI, uri, -1)
+	case strings.HasPrefix(context, "+"):
+		prefix, i := stripSeparators(context)
+		if i >= 0 {
+			return nil, newParseError(InvalidURI, uri, strings.Index(uri, "phone-context="))
+		}
+		c, err = p.parseNormalized(uri, prefix+number, ext)
+	default:
+		c, err = p.parseNormalized(uri, number, ext)
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.Subaddress = isub
+	return c, nil
+}
+
+// TelURI returns the number as an RFC 3966 global tel: URI with its
+// extension and subaddress, e.g. "tel:+385-91-512-5486;ext=148".
+func (c *Phone) TelURI() string {
+	uri := telScheme + strings.Replace(c.FormatInternational(), " ", "-", -1)
+	if ext := digitsOnly(c.Extension); ext != "" {
+		uri += ";ext=" + ext
+	}
+	if c.Subaddress != "" {
+		uri += ";isub=" + url.PathEscape(c.Subaddress)
+	}
+	return uri
+}
+
+// stripSeparators drops the visual separators from s, which may start with
+// "+". It returns the offset of the first character that is neither a digit
+// nor a separator, or -1.
+func stripSeparators(s string) (string, int) {
+	var b strings.Builder
+	for i, r := range s {
+		switch {
+		case r >= '0' && r <= '9', r == '+' && i == 0:
+			b.WriteRune(r)
+		case strings.ContainsRune(visualSeparators, r):
+		default:
+			return "", i
+		}
+	}
+	return b.String(), -1
+}
+
+func hasSchemeFold(uri, scheme string) bool {
+	return len(uri) >= len(scheme) && strings.EqualFold(uri[:len(scheme)], scheme)
+}
+
+// ParseSIPURI extracts the number of a SIP URI with the package default
+// Parser.
+func ParseSIPURI(uri string) (*Phone, error) {
+	return DefaultParser().ParseSIPURI(uri)
+}
+
+// ParseSIPURI extracts the number of a sip: or sips: URI such as
+// "sip:+385915125486@example.com;user=phone". The user part is read as a
+// tel: URI when the user=phone parameter is set or, failing that, when the
+// user part carries a phone-context. A From or To header value like
+// "Alice <sip:...>;tag=1" is accepted as well.
+func (p *Parser) ParseSIPURI(uri string) (*Phone, error) {
+	s, start := uri, 0
+	if i := strings.IndexByte(s, '<'); i >= 0 {
+		if j := strings.IndexByte(s[i:], '>'); j > 0 {
+			s, start = s[i+1:i+j], i+1
+		}
+	}
+	var scheme string
+	switch {
+	case hasSchemeFold(s, "sip:"):
+		scheme = "sip:"
+	case hasSchemeFold(s, "sips:"):
+		scheme = "sips:"
+	default:
+		return nil, newParseError(InvalidURI, uri, start)
+	}
+	rest := s[len(scheme):]
+	at := strings.IndexByte(rest, '@')
+	if at < 0 {
+		return nil, newParseError(InvalidURI, uri, -1)
+	}
+	user, host := rest[:at], rest[at+1:]
+	if i := strings.IndexByte(user, ':'); i >= 0 {
+		user = user[:i]
+	}
+	if i := strings.IndexByte(host, '?'); i >= 0 {
+		host = host[:i]
+	}
+
+	isPhone := strings.Contains(strings.ToLower(user), ";phone-context=")
+	for _, param := range strings.Split(host, ";")[1:] {
+		if strings.EqualFold(param, "user=phone") {
+			isPhone = true
+		}
+	}
+	if !isPhone {
+		return nil, newParseError(InvalidURI, uri, -1)
+	}
+
+	c, err := p.ParseTelURI(telScheme + user)
+	if pe, ok := err.(*ParseError); ok {
+		pe.Input = uri
+		if pe.Offset >= 0 {
+			pe.Offset += start + len(scheme) - len(telScheme)
+		}
+	}
+	return c, err
+}
+
+// SIPURI returns the number as a sip: URI at domain, e.g.
+// "sip:+385915125486@example.com;user=phone".
+func (c *Phone) SIPURI(domain string) string {
+	user := c.E164()
+	if ext := digitsOnly(c.Extension); ext != "" {
+		user += ";ext=" + ext
+	}
+	if c.Subaddress != "" {
+		user += ";isub=" + url.PathEscape(c.Subaddress)
+	}
+	return "sip:" + user + "@" + domain + ";user=phone"
+}
@@ -0,0 +1,118 @@
+package phone
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseTelURI(t *testing.T) {
+	tests := []struct {
+		uri, e164, ext, isub string
+	}{
+		{"tel:+385-91-512-5486", "+385915125486", "", ""},
+		{"tel:+385-91-512-5486;ext=148", "+385915125486", "148", ""},
+		{"TEL:+1-212-555-0100;isub=1411%2F2", "+12125550100", "", "1411/2"},
+		{"tel:512-5486;phone-context=+385-91", "+385915125486", "", ""},
+		{"tel:(212)555-0100;phone-context=+1;foo=bar", "+12125550100", "", ""},
+	}
+	for _, tt := range tests {
+		c, err := ParseTelURI(tt.uri)
+		if err != nil {
+			t.Errorf("ParseTelURI(%q): %v", tt.uri, err)
+			continue
+		}
+		if c.E164() != tt.e164 || c.Extension != tt.ext || c.Subaddress != tt.isub {
+			t.Errorf("ParseTelURI(%q) = %s ext %q isub %q", tt.uri, c.E164(), c.Extension, c.Subaddress)
+		}
+	}
+
+	hr := newParser(t, WithDefaultCountryCode("385"))
+	c, err := hr.ParseTelURI("tel:0915125486;phone-context=example.hr")
+	if err != nil || c.E164() != "+385915125486" {
+		t.Errorf("domain context: %v, %v", c, err)
+	}
+
+	bad := map[string]error{
+		"+385915125486":              ErrInvalidURI,
+		"tel:0915125486":             ErrInvalidURI,
+		"tel:+385 91 512 5486":       ErrInvalidCharacters,
+		"tel:+385915125486;ext=1a":   ErrInvalidURI,
+		"tel:;phone-context=+385":    ErrTooShort,
+		"tel:+385915125486;isub=%zz": ErrInvalidURI,
+	}
+	for uri, want := range bad {
+		if _, err := ParseTelURI(uri); !errors.Is(err, want) {
+			t.Errorf("ParseTelURI(%q) error = %v, want %v", uri, err, want)
+		}
+	}
+}
+
+func TestTelURI(t *testing.T) {
+	c, err := Parse("+385915125486")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Extension = "x148"
+	c.Subaddress = "a b"
+	if got := c.TelURI(); got != "tel:+385-91-512-5486;ext=148;isub=a%20b" {
+		t.Errorf("TelURI() = %q", got)
+	}
+	back, err := ParseTelURI(c.TelURI())
+	if err != nil || back.E164() != c.E164() || back.Subaddress != c.Subaddress {
+		t.Errorf("round trip: %v, %v", back, err)
+	}
+}
+
+func TestParseSIPURI(t *testing.T) {
+	tests := []struct {
+		uri, e164, ext string
+	}{
+		{"sip:+385915125486@example.com;user=phone", "+385915125486", ""},
+		{"SIPS:+1-212-555-0100;ext=11@example.com;transport=tls;user=phone", "+12125550100", "11"},
+		{"sip:5125486;phone-context=+38591@example.com", "+385915125486", ""},
+		{`"Alice" <sip:+385915125486:secret@example.com;user=phone>;tag=1928`, "+385915125486", ""},
+		{"sip:+442079460018@example.com;user=phone?subject=hi", "+442079460018", ""},
+	}
+	for _, tt := range tests {
+		c, err := ParseSIPURI(tt.uri)
+		if err != nil {
+			t.Errorf("ParseSIPURI(%q): %v", tt.uri, err)
+			continue
+		}
+		if c.E164() != tt.e164 || c.Extension != tt.ext {
+			t.Errorf("ParseSIPURI(%q) = %s ext %q", tt.uri, c.E164(), c.Extension)
+		}
+	}
+
+	for _, uri := range []string{
+		"sip:alice@example.com",
+		"sip:+385915125486@example.com",
+		"tel:+385915125486",
+		"sip:+385915125486",
+	} {
+		if _, err := ParseSIPURI(uri); !errors.Is(err, ErrInvalidURI) {
+			t.Errorf("ParseSIPURI(%q) error = %v", uri, err)
+		}
+	}
+
+	_, err := ParseSIPURI("sip:+385 915125486@example.com;user=phone")
+	var pe *ParseError
+	if !errors.As(err, &pe) || pe.Reason != InvalidCharacters || pe.Offset != 8 {
+		t.Errorf("bad character error = %v", err)
+	}
+}
+
+func TestSIPURI(t *testing.T) {
+	c, err := Parse("+385915125486")
+	if err != nil {
+		t.Fatal(err)
+	}
+	uri := c.SIPURI("example.com")
+	if uri != "sip:+385915125486@example.com;user=phone" {
+		t.Errorf("SIPURI() = %q", uri)
+	}
+	back, err := ParseSIPURI(uri)
+	if err != nil || back.E164() != c.E164() {
+		t.Errorf("round trip: %v, %v", back, err)
+	}
+}
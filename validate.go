@@ -0,0 +1,106 @@
+package phone
+
+import "fmt"
+
+// minNationalLength is the national significant number length used for
+// countries whose data has no min_length.
+const minNationalLength = 2
+
+// IsPossible reports whether s parses with the package default Parser to a
+// number of possible length.
+func IsPossible(s string) bool {
+	return DefaultParser().IsPossible(s)
+}
+
+// IsValidNumber reports whether s parses with the package default Parser to
+// a valid number.
+func IsValidNumber(s string) bool {
+	return DefaultParser().IsValidNumber(s)
+}
+
+// IsPossible reports whether s parses to a number of possible length.
+func (p *Parser) IsPossible(s string) bool {
+	c, err := p.Parse(s)
+	return err == nil && c.IsPossible()
+}
+
+// IsValidNumber reports whether s parses to a valid number.
+func (p *Parser) IsValidNumber(s string) bool {
+	c, err := p.Parse(s)
+	return err == nil && c.IsValidNumber()
+}
+
+// LengthRange returns the shortest and longest possible national
+// significant number of the country.
+func (c *Country) LengthRange() (min, max int) {
+	min, max = c.MinLength, c.MaxLength
+	if min == 0 {
+		min = minNationalLength
+	}
+	if max == 0 {
+		max = MaxE164Length - len(c.CountryCode)
+	}
+	return min, max
+}
+
+// IsPossible reports whether the national significant number has a length
+// the country allows. It is cheaper and looser than IsValidNumber.
+func (c *Phone) IsPossible() bool {
+	return c.checkLength() == nil
+}
+
+// IsValidNumber reports whether the number passes Validate.
+func (c *Phone) IsValidNumber() bool {
+	return c.Validate() == nil
+}
+
+// Validate checks the number against its country's data and returns a
+// *ParseError telling which rule failed: UnknownCountryCode, TooShort or
+// TooLong for the length range, InvalidAreaCode for the area_code pattern
+// and InvalidNumber when it is in none of the number_types ranges. Countries
+// without number_types only get the length and area code checks.
+func (c *Phone) Validate() error {
+	if err := c.checkLength(); err != nil {
+		return err
+	}
+	country := c.Country()
+	nsn := c.NationalSignificantNumber()
+	if country.AreaCode != "" && !matchWhole(country.AreaCode, c.AreaCode) {
+		err := newParseError(InvalidAreaCode, c.E164(), len(country.CountryCode)+1)
+		err.Detail = fmt.Sprintf("%q does not match the area codes of %s", c.AreaCode, country.Name)
+		return err
+	}
+	if len(country.NumberTypes) > 0 && country.NumberType(nsn) == Unknown {
+		err := newParseError(InvalidNumber, c.E164(), -1)
+		err.Detail = fmt.Sprintf("%s matches no number type of %s", nsn, country.Name)
+		return err
+	}
+	return nil
+}
+
+func (c *Phone) checkLength() error {
+	country := c.Country()
+	if country == nil {
+		return newParseError(UnknownCountryCode, c.E164(), 0)
+	}
+	min, max := country.LengthRange()
+	var err *ParseError
+	switch n := len(c.NationalSignificantNumber()); {
+	case n < min:
+		err = newParseError(TooShort, c.E164(), -1)
+	case n > max:
+		err = newParseError(TooLong, c.E164(), 1+len(country.CountryCode)+max)
+	default:
+		return nil
+	}
+	err.Detail = fmt.Sprintf("%s numbers have %s", country.Name, lengthRangeString(min, max))
+	return err
+}
+
+// lengthRangeString describes a length range, e.g. "8 to 9 digits".
+func lengthRangeString(min, max int) string {
+	if min == max {
+		return fmt.Sprintf("%d digits", min)
+	}
+	return fmt.Sprintf("%d to %d digits", min, max)
+}
@@ -0,0 +1,49 @@
+package phone
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIsPossible(t *testing.T) {
+	tests := map[string]bool{
+		"+385915125486":   true,
+		"+3859151":        false,
+		"+38591512548612": false,
+		"+12125550100":    true,
+		"+1212555010":     false,
+	}
+	for input, want := range tests {
+		if got := IsPossible(input); got != want {
+			t.Errorf("IsPossible(%q) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := map[string]error{
+		"+385915125486":  nil,
+		"+12125550100":   nil,
+		"+3859151":       ErrTooShort,
+		"+385112345678":  ErrInvalidNumber,
+		"+4915112345678": nil,
+		"+4912345":       ErrInvalidNumber,
+	}
+	for input, want := range tests {
+		c, err := Parse(input)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", input, err)
+			continue
+		}
+		err = c.Validate()
+		if !errors.Is(err, want) || (want == nil && err != nil) {
+			t.Errorf("Parse(%q).Validate() = %v, want %v", input, err, want)
+		}
+		if c.IsValidNumber() != (want == nil) {
+			t.Errorf("Parse(%q).IsValidNumber() = %v", input, c.IsValidNumber())
+		}
+	}
+	if IsValidNumber("+385112345678") {
+		t.Error("IsValidNumber accepted an unallocated number")
+	}
+}